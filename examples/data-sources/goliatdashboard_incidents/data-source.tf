@@ -0,0 +1,10 @@
+data "goliatdashboard_incidents" "last_month" {
+  organization   = "example_organization_id"
+  status         = "resolved"
+  started_after  = "2026-09-01T00:00:00Z"
+  started_before = "2026-10-01T00:00:00Z"
+}
+
+output "incidents_last_month" {
+  value = length(data.goliatdashboard_incidents.last_month.incidents)
+}
@@ -0,0 +1,10 @@
+ephemeral "goliatdashboard_session_token" "deploy" {
+  organization = "example_organization_id"
+  project_id   = goliatdashboard_project.example.id
+  scopes       = ["projects:read"]
+  ttl          = "10m"
+}
+
+provider "example" {
+  token = ephemeral.goliatdashboard_session_token.deploy.token
+}
@@ -0,0 +1,18 @@
+resource "goliatdashboard_alert_rule" "latency" {
+  organization = "example_organization_id"
+  project_id   = goliatdashboard_project.example.id
+  service_id   = goliatdashboard_service.api.service_id
+  name         = "API latency"
+  for_duration = "10m"
+  severity     = "critical"
+
+  condition {
+    metric    = "response_time_ms"
+    operator  = "gt"
+    threshold = 750
+  }
+
+  notification_channel_ids = [
+    goliatdashboard_notification_channel.oncall.channel_id,
+  ]
+}
@@ -0,0 +1,11 @@
+resource "goliatdashboard_api_token" "ci" {
+  organization = "example_organization_id"
+  project_id   = goliatdashboard_project.example.id
+  name         = "ci"
+  scopes       = ["projects:read", "alerts:write"]
+  expires_at   = "2026-01-01T00:00:00Z"
+
+  rotation_trigger = {
+    rotated = "2025-06"
+  }
+}
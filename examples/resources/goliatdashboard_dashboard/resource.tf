@@ -0,0 +1,25 @@
+resource "goliatdashboard_dashboard" "api" {
+  organization = "example_organization_id"
+  project_id   = goliatdashboard_project.example.id
+  name         = "API overview"
+
+  widget {
+    type  = "timeseries"
+    title = "Latency"
+    query = "p95(response_time_ms)"
+    width = 12
+  }
+
+  widget {
+    type  = "stat"
+    title = "Uptime"
+    query = "availability"
+    y     = 4
+  }
+}
+
+resource "goliatdashboard_dashboard" "exported" {
+  organization = "example_organization_id"
+  name         = "Exported from the UI"
+  layout_json  = file("${path.module}/dashboards/exported.json")
+}
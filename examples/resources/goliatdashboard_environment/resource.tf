@@ -0,0 +1,6 @@
+resource "goliatdashboard_environment" "production" {
+  organization = "example_organization_id"
+  project_id   = goliatdashboard_project.example.id
+  name         = "production"
+  description  = "Customer-facing deployment"
+}
@@ -0,0 +1,15 @@
+resource "goliatdashboard_escalation_policy" "api" {
+  organization = "example_organization_id"
+  name         = "API on-call"
+  repeat       = 2
+
+  rule {
+    schedule_ids = [goliatdashboard_schedule.primary.schedule_id]
+    channel_ids  = [goliatdashboard_notification_channel.slack.channel_id]
+  }
+
+  rule {
+    delay_minutes = 15
+    team_ids      = [goliatdashboard_team.sre.team_id]
+  }
+}
@@ -0,0 +1,34 @@
+resource "goliatdashboard_http_check" "login" {
+  organization = "example_organization_id"
+  project_id   = goliatdashboard_project.example.id
+  name         = "Login"
+  url          = "https://api.example.com/login"
+  method       = "POST"
+  body         = jsonencode({ user = "probe" })
+
+  headers = {
+    "Content-Type" = "application/json"
+  }
+
+  assertion {
+    type        = "status_code"
+    status_code = 200
+  }
+
+  assertion {
+    type  = "json_path_equals"
+    path  = "$.session.active"
+    value = "true"
+  }
+
+  assertion {
+    type   = "header_contains"
+    header = "Cache-Control"
+    value  = "no-store"
+  }
+
+  assertion {
+    type         = "response_time_under"
+    milliseconds = 500
+  }
+}
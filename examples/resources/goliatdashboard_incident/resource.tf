@@ -0,0 +1,17 @@
+resource "goliatdashboard_incident" "api_errors" {
+  organization        = "example_organization_id"
+  title               = "Elevated API error rate"
+  severity            = "major"
+  status              = "identified"
+  affected_components = [goliatdashboard_service.api.service_id]
+
+  update {
+    status  = "investigating"
+    message = "We are looking into elevated error rates on the API."
+  }
+
+  update {
+    status  = "identified"
+    message = "A faulty deploy was identified and is being rolled back."
+  }
+}
@@ -0,0 +1,16 @@
+resource "goliatdashboard_maintenance_window" "upgrade" {
+  organization = "example_organization_id"
+  name         = "Database upgrade"
+  start_time   = "2025-03-01T01:00:00Z"
+  end_time     = "2025-03-01T03:00:00Z"
+  project_ids  = [goliatdashboard_project.example.id]
+}
+
+resource "goliatdashboard_maintenance_window" "patching" {
+  organization = "example_organization_id"
+  name         = "Weekly patching"
+  recurrence   = "0 2 * * SUN"
+  duration     = "2h"
+  timezone     = "Europe/Madrid"
+  service_ids  = [goliatdashboard_service.api.service_id]
+}
@@ -0,0 +1,38 @@
+resource "goliatdashboard_notification_channel" "oncall" {
+  organization        = "example_organization_id"
+  name                = "On-call"
+  type                = "slack"
+  send_test_on_create = true
+
+  slack {
+    webhook_url_wo         = var.slack_webhook_url
+    webhook_url_wo_version = 1
+    channel                = "#oncall"
+  }
+}
+
+resource "goliatdashboard_notification_channel" "bridge" {
+  organization = "example_organization_id"
+  name         = "Incident bridge"
+  type         = "webhook"
+
+  webhook {
+    url               = "https://hooks.example.com/goliat"
+    secret_wo         = var.webhook_secret
+    secret_wo_version = 1
+
+    headers = {
+      "X-Source" = "goliat"
+    }
+  }
+}
+
+resource "goliatdashboard_notification_channel" "team" {
+  organization = "example_organization_id"
+  name         = "SRE mailing list"
+  type         = "email"
+
+  email {
+    addresses = ["sre@example.com"]
+  }
+}
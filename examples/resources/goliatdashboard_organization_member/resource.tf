@@ -0,0 +1,5 @@
+resource "goliatdashboard_organization_member" "example" {
+  organization = "example_organization_id"
+  email        = "jane@example.com"
+  role         = "admin"
+}
@@ -0,0 +1,13 @@
+resource "goliatdashboard_organization_members" "example" {
+  organization = "example_organization_id"
+
+  member {
+    email = "owner@example.com"
+    role  = "owner"
+  }
+
+  member {
+    email = "jane@example.com"
+    role  = "admin"
+  }
+}
@@ -0,0 +1,7 @@
+resource "goliatdashboard_organization_settings" "example" {
+  organization          = goliatdashboard_organization.example.name
+  data_retention_days   = 90
+  default_member_role   = "viewer"
+  allowed_email_domains = ["example.com"]
+  sso_enforced          = true
+}
@@ -0,0 +1,6 @@
+resource "goliatdashboard_project_access" "example" {
+  organization = "example_organization_id"
+  project_id   = goliatdashboard_project.example.id
+  team_id      = goliatdashboard_team.example.team_id
+  permission   = "write"
+}
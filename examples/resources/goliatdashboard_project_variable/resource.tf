@@ -0,0 +1,16 @@
+resource "goliatdashboard_project_variable" "log_level" {
+  organization = "example_organization_id"
+  project_id   = goliatdashboard_project.example.id
+  key          = "LOG_LEVEL"
+  value        = "info"
+}
+
+resource "goliatdashboard_project_variable" "database_password" {
+  organization     = "example_organization_id"
+  project_id       = goliatdashboard_project.example.id
+  environment      = goliatdashboard_environment.production.environment_id
+  key              = "DATABASE_PASSWORD"
+  value_wo         = var.database_password
+  value_wo_version = 1
+  sensitive        = true
+}
@@ -0,0 +1,17 @@
+resource "goliatdashboard_role" "auditor" {
+  organization = "example_organization_id"
+  name         = "auditor"
+  description  = "Read-only access for compliance reviews"
+
+  permissions = [
+    "projects:read",
+    "alerts:read",
+    "audit_log:read",
+  ]
+}
+
+resource "goliatdashboard_organization_member" "example" {
+  organization = "example_organization_id"
+  email        = "auditor@example.com"
+  role_id      = goliatdashboard_role.auditor.role_id
+}
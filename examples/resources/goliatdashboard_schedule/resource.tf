@@ -0,0 +1,20 @@
+resource "goliatdashboard_schedule" "primary" {
+  organization = "example_organization_id"
+  name         = "Primary on-call"
+  timezone     = "Europe/Madrid"
+
+  layer {
+    name          = "Weekly"
+    user_ids      = [for m in goliatdashboard_organization_member.sre : m.user_id]
+    rotation_type = "weekly"
+    handoff_day   = "monday"
+    handoff_time  = "09:00"
+  }
+
+  layer {
+    name          = "Weekend cover"
+    user_ids      = [goliatdashboard_organization_member.lead.user_id]
+    rotation_type = "daily"
+    handoff_time  = "18:00"
+  }
+}
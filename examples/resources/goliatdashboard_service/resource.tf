@@ -0,0 +1,20 @@
+resource "goliatdashboard_service" "api" {
+  organization    = "example_organization_id"
+  project_id      = goliatdashboard_project.example.id
+  name            = "API"
+  type            = "http"
+  url             = "https://api.example.com/health"
+  expected_status = 200
+
+  interval_seconds = 30
+  timeout_seconds  = 5
+}
+
+resource "goliatdashboard_service" "database" {
+  organization = "example_organization_id"
+  project_id   = goliatdashboard_project.example.id
+  name         = "Database"
+  type         = "tcp"
+  host         = "db.example.com"
+  port         = 5432
+}
@@ -0,0 +1,16 @@
+resource "goliatdashboard_slo" "latency" {
+  organization = "example_organization_id"
+  service_id   = goliatdashboard_service.api.service_id
+  name         = "API latency"
+  target       = 99.5
+  window       = "28d"
+
+  indicator {
+    type         = "latency"
+    threshold_ms = 300
+  }
+}
+
+output "latency_error_budget" {
+  value = goliatdashboard_slo.latency.error_budget_remaining
+}
@@ -0,0 +1,33 @@
+resource "goliatdashboard_sso_provider" "okta" {
+  organization = "example_organization_id"
+  name         = "Okta"
+  protocol     = "saml"
+
+  saml {
+    metadata_xml = file("${path.module}/okta-metadata.xml")
+  }
+
+  attribute_mapping {
+    email      = "email"
+    first_name = "givenName"
+    last_name  = "sn"
+    groups     = "memberOf"
+  }
+}
+
+resource "goliatdashboard_sso_provider" "google" {
+  organization = "example_organization_id"
+  name         = "Google"
+  protocol     = "oidc"
+
+  oidc {
+    issuer                   = "https://accounts.google.com"
+    client_id                = var.google_client_id
+    client_secret_wo         = var.google_client_secret
+    client_secret_wo_version = 1
+  }
+}
+
+output "okta_acs_url" {
+  value = goliatdashboard_sso_provider.okta.callback_url
+}
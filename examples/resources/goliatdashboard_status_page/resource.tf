@@ -0,0 +1,22 @@
+resource "goliatdashboard_status_page" "public" {
+  organization  = "example_organization_id"
+  slug          = "acme"
+  title         = "Acme Status"
+  custom_domain = "status.acme.com"
+
+  component {
+    display_name = "Website"
+    project_id   = goliatdashboard_project.example.id
+  }
+
+  component {
+    display_name = "API"
+    service_id   = goliatdashboard_service.api.service_id
+    group        = "Backend"
+  }
+
+  theme {
+    primary_color = "#1a73e8"
+    mode          = "dark"
+  }
+}
@@ -0,0 +1,5 @@
+resource "goliatdashboard_team" "example" {
+  organization = "example_organization_id"
+  name         = "SRE"
+  description  = "Site reliability engineering"
+}
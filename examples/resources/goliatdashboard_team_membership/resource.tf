@@ -0,0 +1,6 @@
+resource "goliatdashboard_team_membership" "example" {
+  organization = "example_organization_id"
+  team_id      = goliatdashboard_team.example.team_id
+  user_id      = goliatdashboard_organization_member.example.user_id
+  role         = "maintainer"
+}
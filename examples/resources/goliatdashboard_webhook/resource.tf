@@ -0,0 +1,11 @@
+resource "goliatdashboard_webhook" "incidents" {
+  organization      = "example_organization_id"
+  url               = "https://hooks.example.com/goliat"
+  events            = ["incident.created", "incident.resolved"]
+  secret_wo         = var.webhook_secret
+  secret_wo_version = 1
+}
+
+output "webhook_signing_key" {
+  value = goliatdashboard_webhook.incidents.signing_key_fingerprint
+}
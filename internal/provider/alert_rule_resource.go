@@ -0,0 +1,346 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const alertRulesPath = "/api/public/provider/alert-rules"
+
+// AlertRule fires when Condition has held for ForDuration. Updates keep the
+// rule ID, so the backend preserves the alert history.
+type AlertRule struct {
+	ID                     string             `json:"id"`
+	Organization           string             `json:"organization"`
+	ProjectID              string             `json:"projectId"`
+	ServiceID              string             `json:"serviceId,omitempty"`
+	Name                   string             `json:"name"`
+	Condition              AlertRuleCondition `json:"condition"`
+	ForDuration            string             `json:"forDuration"`
+	Severity               string             `json:"severity"`
+	Enabled                bool               `json:"enabled"`
+	NotificationChannelIDs []string           `json:"notificationChannelIds"`
+}
+
+// AlertRuleCondition compares a metric against a threshold.
+type AlertRuleCondition struct {
+	Metric    string  `json:"metric"`
+	Operator  string  `json:"operator"`
+	Threshold float64 `json:"threshold"`
+}
+
+// AlertRuleListResponse is the body returned when listing the alert rules of
+// an organization.
+type AlertRuleListResponse struct {
+	AlertRules *[]AlertRule `json:"AlertRules"`
+}
+
+// AlertRuleEnvelope is the body returned when creating or updating an alert
+// rule.
+type AlertRuleEnvelope struct {
+	AlertRule *AlertRule `json:"alertRule"`
+}
+
+func resourceAlertRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlertRuleCreate,
+		ReadContext:   resourceAlertRuleRead,
+		UpdateContext: resourceAlertRuleUpdate,
+		DeleteContext: resourceAlertRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"condition": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"operator": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"gt", "gte", "lt", "lte", "eq", "neq"}, false),
+						},
+						"threshold": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+					},
+				},
+			},
+			"for_duration": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "5m",
+				ValidateFunc:     validateDuration,
+				DiffSuppressFunc: suppressEquivalentDuration,
+			},
+			"severity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "warning",
+				ValidateFunc: validation.StringInSlice([]string{"critical", "warning", "info"}, false),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"notification_channel_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rule_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandAlertRuleCondition(raw []interface{}) AlertRuleCondition {
+	var condition AlertRuleCondition
+	if len(raw) == 0 {
+		return condition
+	}
+	m, ok := raw[0].(map[string]interface{})
+	if !ok {
+		return condition
+	}
+	condition.Metric, _ = m["metric"].(string)
+	condition.Operator, _ = m["operator"].(string)
+	condition.Threshold, _ = m["threshold"].(float64)
+	return condition
+}
+
+func flattenAlertRuleCondition(condition AlertRuleCondition) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"metric":    condition.Metric,
+			"operator":  condition.Operator,
+			"threshold": condition.Threshold,
+		},
+	}
+}
+
+func expandAlertRule(d *schema.ResourceData) (AlertRule, error) {
+	organization, ok := d.Get("organization").(string)
+	if !ok {
+		return AlertRule{}, fmt.Errorf("organization must be a string")
+	}
+	projectID, ok := d.Get("project_id").(string)
+	if !ok {
+		return AlertRule{}, fmt.Errorf("project_id must be a string")
+	}
+	serviceID, ok := d.Get("service_id").(string)
+	if !ok {
+		return AlertRule{}, fmt.Errorf("service_id must be a string")
+	}
+	name, ok := d.Get("name").(string)
+	if !ok {
+		return AlertRule{}, fmt.Errorf("name must be a string")
+	}
+	condition, ok := d.Get("condition").([]interface{})
+	if !ok {
+		return AlertRule{}, fmt.Errorf("condition must be a list")
+	}
+	forDuration, ok := d.Get("for_duration").(string)
+	if !ok {
+		return AlertRule{}, fmt.Errorf("for_duration must be a string")
+	}
+	severity, ok := d.Get("severity").(string)
+	if !ok {
+		return AlertRule{}, fmt.Errorf("severity must be a string")
+	}
+	enabled, ok := d.Get("enabled").(bool)
+	if !ok {
+		return AlertRule{}, fmt.Errorf("enabled must be a bool")
+	}
+	channels, ok := d.Get("notification_channel_ids").(*schema.Set)
+	if !ok {
+		return AlertRule{}, fmt.Errorf("notification_channel_ids must be a set")
+	}
+	ruleID, ok := d.Get("rule_id").(string)
+	if !ok {
+		return AlertRule{}, fmt.Errorf("rule_id must be a string")
+	}
+	return AlertRule{
+		ID:                     ruleID,
+		Organization:           organization,
+		ProjectID:              projectID,
+		ServiceID:              serviceID,
+		Name:                   name,
+		Condition:              expandAlertRuleCondition(condition),
+		ForDuration:            forDuration,
+		Severity:               severity,
+		Enabled:                enabled,
+		NotificationChannelIDs: expandStringSet(channels),
+	}, nil
+}
+
+func resourceAlertRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	rule, err := expandAlertRule(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var envelope AlertRuleEnvelope
+	if diags := config.writeJSON(ctx, http.MethodPut, alertRulesPath, "Unable to create alert rule", rule, &envelope); diags.HasError() {
+		return diags
+	}
+	if envelope.AlertRule == nil || envelope.AlertRule.ID == "" {
+		return diag.Errorf("alert rule ID not found in response")
+	}
+
+	d.SetId(buildID(rule.Organization, envelope.AlertRule.ID))
+	return resourceAlertRuleRead(ctx, d, meta)
+}
+
+func resourceAlertRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/rule_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	org, ruleID := parts[0], parts[1]
+
+	var result AlertRuleListResponse
+	gone, diags := config.readJSON(ctx, organizationListPath(alertRulesPath, org), "Unable to read alert rules", &result)
+	if diags.HasError() {
+		return diags
+	}
+	if gone {
+		log.Printf("[WARN] Organization %q not found, removing alert rule %q from state", org, ruleID)
+		d.SetId("")
+		return nil
+	}
+	if result.AlertRules == nil {
+		return diag.Errorf("response does not contain AlertRules")
+	}
+
+	for _, rule := range *result.AlertRules {
+		if rule.ID != ruleID {
+			continue
+		}
+		if err := d.Set("organization", org); err != nil {
+			return diag.Errorf("error setting organization: %s", err)
+		}
+		if err := d.Set("rule_id", rule.ID); err != nil {
+			return diag.Errorf("error setting rule_id: %s", err)
+		}
+		if err := d.Set("project_id", rule.ProjectID); err != nil {
+			return diag.Errorf("error setting project_id: %s", err)
+		}
+		if err := d.Set("service_id", rule.ServiceID); err != nil {
+			return diag.Errorf("error setting service_id: %s", err)
+		}
+		if err := d.Set("name", rule.Name); err != nil {
+			return diag.Errorf("error setting name: %s", err)
+		}
+		if err := d.Set("condition", flattenAlertRuleCondition(rule.Condition)); err != nil {
+			return diag.Errorf("error setting condition: %s", err)
+		}
+		if err := d.Set("for_duration", rule.ForDuration); err != nil {
+			return diag.Errorf("error setting for_duration: %s", err)
+		}
+		if err := d.Set("severity", rule.Severity); err != nil {
+			return diag.Errorf("error setting severity: %s", err)
+		}
+		if err := d.Set("enabled", rule.Enabled); err != nil {
+			return diag.Errorf("error setting enabled: %s", err)
+		}
+		if err := d.Set("notification_channel_ids", rule.NotificationChannelIDs); err != nil {
+			return diag.Errorf("error setting notification_channel_ids: %s", err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] Alert rule %q not found in organization %q, removing from state", ruleID, org)
+	d.SetId("")
+	return nil
+}
+
+// resourceAlertRuleUpdate sends the existing rule ID so the backend updates
+// the rule in place rather than replacing it and dropping its history.
+func resourceAlertRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	rule, err := expandAlertRule(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := config.writeJSON(ctx, http.MethodPut, alertRulesPath, "Unable to update alert rule", rule, nil); diags.HasError() {
+		return diags
+	}
+	return resourceAlertRuleRead(ctx, d, meta)
+}
+
+func resourceAlertRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/rule_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	payload := map[string]string{
+		"id":           parts[1],
+		"organization": parts[0],
+	}
+	if diags := config.deleteJSON(ctx, alertRulesPath, "Unable to delete alert rule", payload); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccAlertRuleResource(t *testing.T) {
+	token := os.Getenv("EXAMPLE_TOKEN")
+	if token == "" {
+		t.Fatal("EXAMPLE_TOKEN is not set in environment variables")
+	}
+
+	config := func(threshold string) string {
+		return `
+provider "goliatdashboard" {
+  backend_url = "https://demo.goliat-dashboard.com"
+  token       = "` + token + `"
+}
+
+resource "goliatdashboard_organization" "test_org" {
+  name = "new_provider_org"
+  type = "providerOrganizations"
+}
+
+resource "goliatdashboard_project" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  name         = "Test Project"
+}
+
+resource "goliatdashboard_alert_rule" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  project_id   = goliatdashboard_project.test.id
+  name         = "Latency"
+  for_duration = "10m"
+  severity     = "critical"
+
+  condition {
+    metric    = "response_time_ms"
+    operator  = "gt"
+    threshold = ` + threshold + `
+  }
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"goliatdashboard": func() (*schema.Provider, error) { //nolint:unparam
+				return Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("500"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_alert_rule.test", "condition.0.threshold", "500"),
+					resource.TestCheckResourceAttrSet("goliatdashboard_alert_rule.test", "rule_id"),
+				),
+			},
+			{
+				Config: config("750"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_alert_rule.test", "condition.0.threshold", "750"),
+				),
+			},
+			{
+				ResourceName:      "goliatdashboard_alert_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceAlertRuleRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadFixture(t, "alert_rule_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceAlertRule().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org/rule_1")
+
+	diags := resourceAlertRuleRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "rule_1", d.Get("rule_id"))
+	assert.Equal(t, "response_time_ms", d.Get("condition.0.metric"))
+	assert.Equal(t, 750.5, d.Get("condition.0.threshold"))
+	assert.Equal(t, "5m0s", d.Get("for_duration"))
+	assert.Equal(t, []string{"channel_1", "channel_2"}, expandStringSet(d.Get("notification_channel_ids").(*schema.Set))) //nolint:forcetypeassert
+}
+
+func TestResourceAlertRuleUpdate_KeepsID(t *testing.T) {
+	var sent AlertRule
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			_ = json.NewDecoder(r.Body).Decode(&sent)
+			_, _ = w.Write([]byte(`{"alertRule":{"id":"rule_1"}}`))
+			return
+		}
+		_, _ = w.Write(loadFixture(t, "alert_rule_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceAlertRule().Schema, map[string]interface{}{
+		"organization": "new_provider_org",
+		"project_id":   "project_1",
+		"name":         "API latency",
+		"for_duration": "10m",
+		"condition": []interface{}{
+			map[string]interface{}{"metric": "response_time_ms", "operator": "gt", "threshold": 900.0},
+		},
+	})
+	d.SetId("new_provider_org/rule_1")
+	assert.NoError(t, d.Set("rule_id", "rule_1"))
+
+	diags := resourceAlertRuleUpdate(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "rule_1", sent.ID)
+	assert.Equal(t, 900.0, sent.Condition.Threshold)
+	assert.Equal(t, "10m", sent.ForDuration)
+	assert.True(t, sent.Enabled)
+}
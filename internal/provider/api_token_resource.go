@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const apiTokensPath = "/api/public/provider/api-tokens"
+
+// APIToken is a scoped credential for the dashboard API. Token is only
+// returned by the backend when the token is created.
+type APIToken struct {
+	ID           string   `json:"id"`
+	Organization string   `json:"organization"`
+	ProjectID    string   `json:"projectId,omitempty"`
+	Name         string   `json:"name"`
+	Scopes       []string `json:"scopes"`
+	RoleID       string   `json:"roleId,omitempty"`
+	ExpiresAt    string   `json:"expiresAt,omitempty"`
+	Revoked      bool     `json:"revoked"`
+	Token        string   `json:"token,omitempty"`
+}
+
+// APITokenListResponse is the body returned when listing the API tokens of an
+// organization. Secrets are never included.
+type APITokenListResponse struct {
+	APITokens *[]APIToken `json:"ApiTokens"`
+}
+
+// APITokenEnvelope is the body returned when creating an API token.
+type APITokenEnvelope struct {
+	APIToken *APIToken `json:"apiToken"`
+}
+
+// expired reports whether the token can no longer be used at now.
+func (t APIToken) expired(now time.Time) bool {
+	if t.Revoked {
+		return true
+	}
+	if t.ExpiresAt == "" {
+		return false
+	}
+	expiresAt, err := time.Parse(time.RFC3339, t.ExpiresAt)
+	if err != nil {
+		return false
+	}
+	return !now.Before(expiresAt)
+}
+
+func resourceAPIToken() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAPITokenCreate,
+		ReadContext:   resourceAPITokenRead,
+		DeleteContext: resourceAPITokenDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"scopes": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(permissionPattern, "must be a permission such as \"projects:read\""),
+				},
+			},
+			"role_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"expires_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339,
+			},
+			"rotation_trigger": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"token_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func expandAPIToken(d *schema.ResourceData) (APIToken, error) {
+	organization, ok := d.Get("organization").(string)
+	if !ok {
+		return APIToken{}, fmt.Errorf("organization must be a string")
+	}
+	projectID, ok := d.Get("project_id").(string)
+	if !ok {
+		return APIToken{}, fmt.Errorf("project_id must be a string")
+	}
+	name, ok := d.Get("name").(string)
+	if !ok {
+		return APIToken{}, fmt.Errorf("name must be a string")
+	}
+	scopes, ok := d.Get("scopes").(*schema.Set)
+	if !ok {
+		return APIToken{}, fmt.Errorf("scopes must be a set")
+	}
+	roleID, ok := d.Get("role_id").(string)
+	if !ok {
+		return APIToken{}, fmt.Errorf("role_id must be a string")
+	}
+	expiresAt, ok := d.Get("expires_at").(string)
+	if !ok {
+		return APIToken{}, fmt.Errorf("expires_at must be a string")
+	}
+	return APIToken{
+		Organization: organization,
+		ProjectID:    projectID,
+		Name:         name,
+		Scopes:       expandStringSet(scopes),
+		RoleID:       roleID,
+		ExpiresAt:    expiresAt,
+	}, nil
+}
+
+func resourceAPITokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	token, err := expandAPIToken(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var envelope APITokenEnvelope
+	if diags := config.writeJSON(ctx, http.MethodPut, apiTokensPath, "Unable to create API token", token, &envelope); diags.HasError() {
+		return diags
+	}
+	if envelope.APIToken == nil || envelope.APIToken.ID == "" {
+		return diag.Errorf("API token ID not found in response")
+	}
+	if envelope.APIToken.Token == "" {
+		return diag.Errorf("API token secret not found in response")
+	}
+
+	d.SetId(buildID(token.Organization, envelope.APIToken.ID))
+	// The secret is only returned on creation; Read never overwrites it.
+	if err := d.Set("token", envelope.APIToken.Token); err != nil {
+		return diag.Errorf("error setting token: %s", err)
+	}
+	return resourceAPITokenRead(ctx, d, meta)
+}
+
+func resourceAPITokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/token_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	org, tokenID := parts[0], parts[1]
+
+	var result APITokenListResponse
+	gone, diags := config.readJSON(ctx, organizationListPath(apiTokensPath, org), "Unable to read API tokens", &result)
+	if diags.HasError() {
+		return diags
+	}
+	if gone {
+		log.Printf("[WARN] Organization %q not found, removing API token %q from state", org, tokenID)
+		d.SetId("")
+		return nil
+	}
+	if result.APITokens == nil {
+		return diag.Errorf("response does not contain ApiTokens")
+	}
+
+	for _, token := range *result.APITokens {
+		if token.ID != tokenID {
+			continue
+		}
+		// An expired or revoked token is treated as gone so the next plan
+		// mints a replacement.
+		if token.expired(time.Now()) {
+			log.Printf("[WARN] API token %q has expired or was revoked, removing from state", tokenID)
+			d.SetId("")
+			return nil
+		}
+		if err := d.Set("organization", org); err != nil {
+			return diag.Errorf("error setting organization: %s", err)
+		}
+		if err := d.Set("token_id", token.ID); err != nil {
+			return diag.Errorf("error setting token_id: %s", err)
+		}
+		if err := d.Set("project_id", token.ProjectID); err != nil {
+			return diag.Errorf("error setting project_id: %s", err)
+		}
+		if err := d.Set("name", token.Name); err != nil {
+			return diag.Errorf("error setting name: %s", err)
+		}
+		if err := d.Set("scopes", token.Scopes); err != nil {
+			return diag.Errorf("error setting scopes: %s", err)
+		}
+		if err := d.Set("role_id", token.RoleID); err != nil {
+			return diag.Errorf("error setting role_id: %s", err)
+		}
+		if err := d.Set("expires_at", token.ExpiresAt); err != nil {
+			return diag.Errorf("error setting expires_at: %s", err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] API token %q not found in organization %q, removing from state", tokenID, org)
+	d.SetId("")
+	return nil
+}
+
+func resourceAPITokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/token_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	payload := map[string]string{
+		"id":           parts[1],
+		"organization": parts[0],
+	}
+	if diags := config.deleteJSON(ctx, apiTokensPath, "Unable to revoke API token", payload); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPITokenExpired(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.False(t, APIToken{}.expired(now))
+	assert.False(t, APIToken{ExpiresAt: "2025-06-02T00:00:00Z"}.expired(now))
+	assert.True(t, APIToken{ExpiresAt: "2025-06-01T00:00:00Z"}.expired(now))
+	assert.True(t, APIToken{Revoked: true}.expired(now))
+}
+
+func TestResourceAPITokenCreate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			_, _ = w.Write([]byte(`{"apiToken":{"id":"tok_active","token":"gd_secret"}}`))
+			return
+		}
+		_, _ = w.Write(loadFixture(t, "api_token_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceAPIToken().Schema, map[string]interface{}{
+		"organization": "new_provider_org",
+		"project_id":   "6745b9c1e4b0a1d2c3f4a5b6",
+		"name":         "ci",
+		"scopes":       []interface{}{"projects:read", "alerts:write"},
+		"expires_at":   "2999-01-01T00:00:00Z",
+	})
+
+	diags := resourceAPITokenCreate(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "new_provider_org/tok_active", d.Id())
+	assert.Equal(t, "gd_secret", d.Get("token"))
+	assert.Equal(t, "tok_active", d.Get("token_id"))
+}
+
+func TestResourceAPITokenRead_Expired(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadFixture(t, "api_token_list.json"))
+	}))
+	defer server.Close()
+
+	for _, id := range []string{"tok_expired", "tok_revoked"} {
+		d := schema.TestResourceDataRaw(t, resourceAPIToken().Schema, map[string]interface{}{})
+		d.SetId("new_provider_org/" + id)
+
+		diags := resourceAPITokenRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+		assert.False(t, diags.HasError(), id)
+		assert.Empty(t, d.Id(), id)
+	}
+}
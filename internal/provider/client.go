@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// responseSnippetLimit caps how much of a response body is echoed back in
+// errors and diagnostics, so an HTML error page does not flood the output.
+const responseSnippetLimit = 512
+
+// responseClass groups backend status codes by how a resource should react.
+type responseClass int
+
+const (
+	responseOK responseClass = iota
+	responseNotFound
+	responseUnauthorized
+	responseServerError
+	responseUnexpected
+)
+
+// classifyResponse maps a backend status code to a responseClass.
+func classifyResponse(statusCode int) responseClass {
+	switch {
+	case statusCode >= 200 && statusCode < 300:
+		return responseOK
+	case statusCode == http.StatusNotFound, statusCode == http.StatusGone:
+		return responseNotFound
+	case statusCode == http.StatusUnauthorized, statusCode == http.StatusForbidden:
+		return responseUnauthorized
+	case statusCode >= 500:
+		return responseServerError
+	}
+	return responseUnexpected
+}
+
+// isDeleteSuccess reports whether a DELETE response status means the object
+// no longer exists on the backend. 404 and 410 are accepted so that a destroy
+// retried after a partial failure, or after the object was removed in the UI,
+// still completes.
+func isDeleteSuccess(statusCode int) bool {
+	switch statusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound, http.StatusGone:
+		return true
+	}
+	return false
+}
+
+// responseSnippet returns the body trimmed and truncated to
+// responseSnippetLimit bytes.
+func responseSnippet(body []byte) string {
+	snippet := strings.TrimSpace(string(body))
+	if snippet == "" {
+		return "(empty)"
+	}
+	if len(snippet) > responseSnippetLimit {
+		return snippet[:responseSnippetLimit] + "... (truncated)"
+	}
+	return snippet
+}
+
+// responseDiagnostic builds an error diagnostic for a response that could not
+// be used, explaining the failure according to its status code.
+func responseDiagnostic(summary string, statusCode int, body []byte) diag.Diagnostic {
+	var reason string
+	switch classifyResponse(statusCode) {
+	case responseUnauthorized:
+		reason = "The backend rejected the provider token. Check that `token` is valid and has access to this object."
+	case responseServerError:
+		reason = "The backend failed to process the request. This is usually transient; retry the operation."
+	default:
+		reason = "The backend returned an unexpected status code."
+	}
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  summary,
+		Detail:   fmt.Sprintf("%s\n\nStatus code: %d\nResponse: %s", reason, statusCode, responseSnippet(body)),
+	}
+}
+
+// decodeDiagnostic builds an error diagnostic for a response body that could
+// not be decoded.
+func decodeDiagnostic(summary string, err error, body []byte) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  summary,
+		Detail:   fmt.Sprintf("Error decoding response: %s\n\nResponse: %s", err, responseSnippet(body)),
+	}
+}
+
+// flexString is a string that also decodes from JSON null, numbers and
+// booleans, for backend fields whose type is not consistent across versions.
+type flexString string
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *flexString) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch val := v.(type) {
+	case nil:
+		*s = ""
+	case string:
+		*s = flexString(val)
+	case float64, bool:
+		*s = flexString(strings.TrimSpace(string(data)))
+	default:
+		return fmt.Errorf("expected a string, number or null but got %s", responseSnippet(data))
+	}
+	return nil
+}
+
+// organizationListPath returns path filtered to the objects of org.
+func organizationListPath(path, org string) string {
+	return path + "?organization=" + url.QueryEscape(org)
+}
+
+// doRequest sends an authenticated request to the backend and returns the
+// status code and full response body. A non-nil payload is sent as JSON.
+func (c *Config) doRequest(ctx context.Context, method, path string, payload interface{}) (int, []byte, error) {
+	var reqBody io.Reader
+	if payload != nil {
+		body, err := json.Marshal(payload)
+		if err != nil {
+			return 0, nil, fmt.Errorf("error converting data to JSON: %s", err)
+		}
+		reqBody = bytes.NewBuffer(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.BackendURL+path, reqBody)
+	if err != nil {
+		return 0, nil, fmt.Errorf("error creating %s request: %s", method, err)
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, nil, fmt.Errorf("error sending %s request to backend: %s", method, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, nil, fmt.Errorf("error reading response: %s", err)
+	}
+	return resp.StatusCode, body, nil
+}
+
+// readJSON sends a GET request for path and decodes a successful response into
+// out. gone is true when the backend confirms the object does not exist, in
+// which case the caller should remove the resource from state.
+func (c *Config) readJSON(ctx context.Context, path, summary string, out interface{}) (bool, diag.Diagnostics) {
+	statusCode, body, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return false, diag.FromErr(err)
+	}
+
+	switch classifyResponse(statusCode) {
+	case responseOK:
+	case responseNotFound:
+		return true, nil
+	default:
+		return false, diag.Diagnostics{responseDiagnostic(summary, statusCode, body)}
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return false, diag.Diagnostics{decodeDiagnostic(summary, err, body)}
+	}
+	return false, nil
+}
+
+// writeJSON sends payload to path with the given method and decodes a
+// successful response into out, unless out is nil.
+func (c *Config) writeJSON(ctx context.Context, method, path, summary string, payload, out interface{}) diag.Diagnostics {
+	statusCode, body, err := c.doRequest(ctx, method, path, payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if classifyResponse(statusCode) != responseOK {
+		return diag.Diagnostics{responseDiagnostic(summary, statusCode, body)}
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return diag.Diagnostics{decodeDiagnostic(summary, err, body)}
+	}
+	return nil
+}
+
+// deleteJSON sends a DELETE request for path, treating an object that is
+// already gone as deleted.
+func (c *Config) deleteJSON(ctx context.Context, path, summary string, payload interface{}) diag.Diagnostics {
+	statusCode, body, err := c.doRequest(ctx, http.MethodDelete, path, payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !isDeleteSuccess(statusCode) {
+		return diag.Diagnostics{responseDiagnostic(summary, statusCode, body)}
+	}
+	return nil
+}
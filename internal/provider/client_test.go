@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsDeleteSuccess(t *testing.T) {
+	cases := map[int]bool{
+		http.StatusOK:                  true,
+		http.StatusNoContent:           true,
+		http.StatusNotFound:            true,
+		http.StatusGone:                true,
+		http.StatusUnauthorized:        false,
+		http.StatusConflict:            false,
+		http.StatusInternalServerError: false,
+	}
+
+	for status, want := range cases {
+		assert.Equal(t, want, isDeleteSuccess(status), "status %d", status)
+	}
+}
+
+func TestResponseSnippet(t *testing.T) {
+	assert.Equal(t, "(empty)", responseSnippet(nil))
+	assert.Equal(t, "bad gateway", responseSnippet([]byte("  bad gateway\n")))
+
+	long := strings.Repeat("x", responseSnippetLimit+10)
+	snippet := responseSnippet([]byte(long))
+	assert.True(t, strings.HasSuffix(snippet, "... (truncated)"))
+	assert.Len(t, snippet, responseSnippetLimit+len("... (truncated)"))
+}
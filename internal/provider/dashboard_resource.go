@@ -0,0 +1,480 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const dashboardsPath = "/api/public/provider/dashboards"
+
+const (
+	dashboardDefaultColumns      = 12
+	dashboardDefaultWidgetWidth  = 6
+	dashboardDefaultWidgetHeight = 4
+)
+
+// dashboardLayoutDefaults and dashboardWidgetDefaults are the values the
+// backend fills in when a layout omits them. They are stripped before layouts
+// are compared, so spelling them out does not produce a diff.
+var (
+	dashboardLayoutDefaults = map[string]interface{}{
+		"columns":        float64(dashboardDefaultColumns),
+		"refreshSeconds": float64(60),
+		"timeRange":      "24h",
+	}
+	dashboardWidgetDefaults = map[string]interface{}{
+		"x":      float64(0),
+		"y":      float64(0),
+		"width":  float64(dashboardDefaultWidgetWidth),
+		"height": float64(dashboardDefaultWidgetHeight),
+		"query":  "",
+	}
+)
+
+// Dashboard is a grid of widgets. Layout is the raw layout document.
+type Dashboard struct {
+	ID           string          `json:"id"`
+	Organization string          `json:"organization"`
+	ProjectID    string          `json:"projectId,omitempty"`
+	Name         string          `json:"name"`
+	Layout       json.RawMessage `json:"layout"`
+}
+
+// DashboardLayout is the part of a layout document the widget blocks map to.
+type DashboardLayout struct {
+	Columns int               `json:"columns"`
+	Widgets []DashboardWidget `json:"widgets"`
+}
+
+// DashboardWidget is a single panel of a dashboard.
+type DashboardWidget struct {
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Query  string `json:"query,omitempty"`
+	X      int    `json:"x"`
+	Y      int    `json:"y"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+// DashboardListResponse is the body returned when listing the dashboards of
+// an organization.
+type DashboardListResponse struct {
+	Dashboards *[]Dashboard `json:"Dashboards"`
+}
+
+// DashboardEnvelope is the body returned when creating or updating a
+// dashboard.
+type DashboardEnvelope struct {
+	Dashboard *Dashboard `json:"dashboard"`
+}
+
+func resourceDashboard() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDashboardCreate,
+		ReadContext:   resourceDashboardRead,
+		UpdateContext: resourceDashboardUpdate,
+		DeleteContext: resourceDashboardDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"layout_json": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ConflictsWith:    []string{"widget"},
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentDashboardLayout,
+			},
+			"widget": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"timeseries", "stat", "table", "status", "text"}, false),
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"query": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"x": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, dashboardDefaultColumns-1),
+						},
+						"y": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"width": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      dashboardDefaultWidgetWidth,
+							ValidateFunc: validation.IntBetween(1, dashboardDefaultColumns),
+						},
+						"height": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      dashboardDefaultWidgetHeight,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"dashboard_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: resourceDashboardCustomizeDiff,
+	}
+}
+
+// resourceDashboardCustomizeDiff marks the representation that is not
+// configured as unknown when the other one changes, since both are derived
+// from the same layout.
+func resourceDashboardCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	// Both representations are computed, so a configuration that drops every
+	// widget block without setting layout_json would otherwise keep the
+	// widgets in state. Plan an empty layout instead.
+	if dashboardLayoutUnset(d.GetRawConfig()) {
+		if widgets, ok := d.Get("widget").([]interface{}); ok && len(widgets) > 0 {
+			if err := d.SetNew("widget", []interface{}{}); err != nil {
+				return err
+			}
+			return d.SetNewComputed("layout_json")
+		}
+	}
+	if d.HasChange("widget") {
+		return d.SetNewComputed("layout_json")
+	}
+	if d.HasChange("layout_json") {
+		return d.SetNewComputed("widget")
+	}
+	return nil
+}
+
+// dashboardLayoutUnset reports whether config sets neither layout_json nor
+// any widget block.
+func dashboardLayoutUnset(config cty.Value) bool {
+	if config.IsNull() || !config.IsKnown() {
+		return false
+	}
+	if !config.GetAttr("layout_json").IsNull() {
+		return false
+	}
+	widgets := config.GetAttr("widget")
+	return widgets.IsKnown() && (widgets.IsNull() || widgets.LengthInt() == 0)
+}
+
+// normalizeDashboardLayout returns layout re-encoded with sorted keys and
+// without the values the backend would fill in by default.
+func normalizeDashboardLayout(layout string) (string, error) {
+	var doc interface{}
+	if err := json.Unmarshal([]byte(layout), &doc); err != nil {
+		return "", err
+	}
+	if m, ok := doc.(map[string]interface{}); ok {
+		stripDefaults(m, dashboardLayoutDefaults)
+		if widgets, ok := m["widgets"].([]interface{}); ok {
+			for _, w := range widgets {
+				if widget, ok := w.(map[string]interface{}); ok {
+					stripDefaults(widget, dashboardWidgetDefaults)
+				}
+			}
+			if len(widgets) == 0 {
+				delete(m, "widgets")
+			}
+		}
+	}
+	normalized, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+	return string(normalized), nil
+}
+
+// stripDefaults deletes the keys of m whose value equals the default.
+func stripDefaults(m, defaults map[string]interface{}) {
+	for k, def := range defaults {
+		if v, ok := m[k]; ok && v == def {
+			delete(m, k)
+		}
+	}
+}
+
+// suppressEquivalentDashboardLayout suppresses the diff between two layouts
+// that only differ in key order, whitespace or spelled-out defaults.
+func suppressEquivalentDashboardLayout(_, old, new string, _ *schema.ResourceData) bool {
+	oldLayout, err := normalizeDashboardLayout(old)
+	if err != nil {
+		return false
+	}
+	newLayout, err := normalizeDashboardLayout(new)
+	if err != nil {
+		return false
+	}
+	return oldLayout == newLayout
+}
+
+func expandDashboardWidgets(raw []interface{}) []DashboardWidget {
+	widgets := make([]DashboardWidget, 0, len(raw))
+	for _, item := range raw {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		var w DashboardWidget
+		w.Type, _ = m["type"].(string)
+		w.Title, _ = m["title"].(string)
+		w.Query, _ = m["query"].(string)
+		w.X, _ = m["x"].(int)
+		w.Y, _ = m["y"].(int)
+		w.Width, _ = m["width"].(int)
+		w.Height, _ = m["height"].(int)
+		widgets = append(widgets, w)
+	}
+	return widgets
+}
+
+// flattenDashboardWidgets extracts the widget blocks from a layout document,
+// filling in the defaults the document may omit.
+func flattenDashboardWidgets(layout []byte) ([]interface{}, error) {
+	var doc DashboardLayout
+	if err := json.Unmarshal(layout, &doc); err != nil {
+		return nil, err
+	}
+	result := make([]interface{}, 0, len(doc.Widgets))
+	for _, w := range doc.Widgets {
+		if w.Width == 0 {
+			w.Width = dashboardDefaultWidgetWidth
+		}
+		if w.Height == 0 {
+			w.Height = dashboardDefaultWidgetHeight
+		}
+		result = append(result, map[string]interface{}{
+			"type":   w.Type,
+			"title":  w.Title,
+			"query":  w.Query,
+			"x":      w.X,
+			"y":      w.Y,
+			"width":  w.Width,
+			"height": w.Height,
+		})
+	}
+	return result, nil
+}
+
+func expandDashboard(d *schema.ResourceData) (Dashboard, error) {
+	organization, ok := d.Get("organization").(string)
+	if !ok {
+		return Dashboard{}, fmt.Errorf("organization must be a string")
+	}
+	projectID, ok := d.Get("project_id").(string)
+	if !ok {
+		return Dashboard{}, fmt.Errorf("project_id must be a string")
+	}
+	name, ok := d.Get("name").(string)
+	if !ok {
+		return Dashboard{}, fmt.Errorf("name must be a string")
+	}
+	dashboardID, ok := d.Get("dashboard_id").(string)
+	if !ok {
+		return Dashboard{}, fmt.Errorf("dashboard_id must be a string")
+	}
+	dashboard := Dashboard{
+		ID:           dashboardID,
+		Organization: organization,
+		ProjectID:    projectID,
+		Name:         name,
+	}
+
+	// layout_json is unknown while the widget blocks are being changed, so
+	// it is only empty when the layout has to be built from the widgets.
+	layout, ok := d.Get("layout_json").(string)
+	if !ok {
+		return Dashboard{}, fmt.Errorf("layout_json must be a string")
+	}
+	if layout != "" {
+		dashboard.Layout = json.RawMessage(layout)
+		return dashboard, nil
+	}
+
+	widgets, ok := d.Get("widget").([]interface{})
+	if !ok {
+		return Dashboard{}, fmt.Errorf("widget must be a list")
+	}
+	built, err := json.Marshal(DashboardLayout{
+		Columns: dashboardDefaultColumns,
+		Widgets: expandDashboardWidgets(widgets),
+	})
+	if err != nil {
+		return Dashboard{}, err
+	}
+	dashboard.Layout = built
+	return dashboard, nil
+}
+
+func resourceDashboardCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	dashboard, err := expandDashboard(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var envelope DashboardEnvelope
+	if diags := config.writeJSON(ctx, http.MethodPut, dashboardsPath, "Unable to create dashboard", dashboard, &envelope); diags.HasError() {
+		return diags
+	}
+	if envelope.Dashboard == nil || envelope.Dashboard.ID == "" {
+		return diag.Errorf("dashboard ID not found in response")
+	}
+
+	d.SetId(buildID(dashboard.Organization, envelope.Dashboard.ID))
+	return resourceDashboardRead(ctx, d, meta)
+}
+
+func resourceDashboardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/dashboard_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	org, dashboardID := parts[0], parts[1]
+
+	var result DashboardListResponse
+	gone, diags := config.readJSON(ctx, organizationListPath(dashboardsPath, org), "Unable to read dashboards", &result)
+	if diags.HasError() {
+		return diags
+	}
+	if gone {
+		log.Printf("[WARN] Organization %q not found, removing dashboard %q from state", org, dashboardID)
+		d.SetId("")
+		return nil
+	}
+	if result.Dashboards == nil {
+		return diag.Errorf("response does not contain Dashboards")
+	}
+
+	for _, dashboard := range *result.Dashboards {
+		if dashboard.ID != dashboardID {
+			continue
+		}
+		layout, err := normalizeDashboardLayout(string(dashboard.Layout))
+		if err != nil {
+			return diag.Errorf("unable to decode layout of dashboard %q: %s", dashboardID, err)
+		}
+		widgets, err := flattenDashboardWidgets(dashboard.Layout)
+		if err != nil {
+			return diag.Errorf("unable to decode widgets of dashboard %q: %s", dashboardID, err)
+		}
+		if err := d.Set("organization", org); err != nil {
+			return diag.Errorf("error setting organization: %s", err)
+		}
+		if err := d.Set("dashboard_id", dashboard.ID); err != nil {
+			return diag.Errorf("error setting dashboard_id: %s", err)
+		}
+		if err := d.Set("project_id", dashboard.ProjectID); err != nil {
+			return diag.Errorf("error setting project_id: %s", err)
+		}
+		if err := d.Set("name", dashboard.Name); err != nil {
+			return diag.Errorf("error setting name: %s", err)
+		}
+		if err := d.Set("layout_json", layout); err != nil {
+			return diag.Errorf("error setting layout_json: %s", err)
+		}
+		if err := d.Set("widget", widgets); err != nil {
+			return diag.Errorf("error setting widget: %s", err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] Dashboard %q not found in organization %q, removing from state", dashboardID, org)
+	d.SetId("")
+	return nil
+}
+
+func resourceDashboardUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	dashboard, err := expandDashboard(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := config.writeJSON(ctx, http.MethodPut, dashboardsPath, "Unable to update dashboard", dashboard, nil); diags.HasError() {
+		return diags
+	}
+	return resourceDashboardRead(ctx, d, meta)
+}
+
+func resourceDashboardDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/dashboard_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	payload := map[string]string{
+		"id":           parts[1],
+		"organization": parts[0],
+	}
+	if diags := config.deleteJSON(ctx, dashboardsPath, "Unable to delete dashboard", payload); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDashboardResource(t *testing.T) {
+	token := os.Getenv("EXAMPLE_TOKEN")
+	if token == "" {
+		t.Fatal("EXAMPLE_TOKEN is not set in environment variables")
+	}
+
+	config := func(title string) string {
+		return `
+provider "goliatdashboard" {
+  backend_url = "https://demo.goliat-dashboard.com"
+  token       = "` + token + `"
+}
+
+resource "goliatdashboard_organization" "test_org" {
+  name = "new_provider_org"
+  type = "providerOrganizations"
+}
+
+resource "goliatdashboard_dashboard" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  name         = "Provider Test"
+  layout_json = jsonencode({
+    columns = 12
+    widgets = [
+      { type = "stat", title = "` + title + `", query = "availability", width = 6, height = 4 },
+    ]
+  })
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"goliatdashboard": func() (*schema.Provider, error) { //nolint:unparam
+				return Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("Uptime"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_dashboard.test", "widget.0.title", "Uptime"),
+					resource.TestCheckResourceAttrSet("goliatdashboard_dashboard.test", "dashboard_id"),
+				),
+			},
+			{
+				Config: config("Availability"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_dashboard.test", "widget.0.title", "Availability"),
+				),
+			},
+			{
+				ResourceName:      "goliatdashboard_dashboard.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestNormalizeDashboardLayout(t *testing.T) {
+	a := `{"widgets":[{"title":"Latency","type":"timeseries","x":0,"y":0,"width":6,"height":4}],"columns":12}`
+	b := `{
+  "columns": 12,
+  "timeRange": "24h",
+  "widgets": [
+    {"type": "timeseries", "title": "Latency", "query": ""}
+  ]
+}`
+	normalized, err := normalizeDashboardLayout(a)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"widgets":[{"title":"Latency","type":"timeseries"}]}`, normalized)
+
+	assert.True(t, suppressEquivalentDashboardLayout("", a, b, nil))
+	assert.False(t, suppressEquivalentDashboardLayout("", a, `{"widgets":[{"title":"Latency","type":"timeseries","width":12}]}`, nil))
+	assert.False(t, suppressEquivalentDashboardLayout("", a, `not json`, nil))
+	assert.True(t, suppressEquivalentDashboardLayout("", `{}`, `{"columns":12,"widgets":[]}`, nil))
+}
+
+func TestResourceDashboardRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadFixture(t, "dashboard_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceDashboard().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org/dashboard_1")
+
+	diags := resourceDashboardRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, `{"widgets":[{"query":"p95(response_time_ms)","title":"Latency","type":"timeseries","width":12},{"query":"availability","title":"Uptime","type":"stat","y":4}]}`, d.Get("layout_json"))
+	assert.Equal(t, 2, d.Get("widget.#"))
+	assert.Equal(t, 12, d.Get("widget.0.width"))
+	assert.Equal(t, 6, d.Get("widget.1.width"))
+	assert.Equal(t, 4, d.Get("widget.1.y"))
+}
+
+func TestResourceDashboardCustomizeDiff_RemoveAllWidgets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadFixture(t, "dashboard_list.json"))
+	}))
+	defer server.Close()
+
+	r := resourceDashboard()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	d.SetId("new_provider_org/dashboard_1")
+	diags := resourceDashboardRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	state := d.State()
+
+	block := r.CoreConfigSchema()
+	raw, err := block.CoerceValue(cty.ObjectVal(map[string]cty.Value{
+		"organization": cty.StringVal("new_provider_org"),
+		"project_id":   cty.StringVal("project_1"),
+		"name":         cty.StringVal("API overview"),
+	}))
+	assert.NoError(t, err)
+	state.RawConfig = raw
+
+	// Without widget blocks or layout_json the dashboard is planned empty
+	// rather than keeping the widgets from state.
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigShimmed(raw, block), nil)
+	assert.NoError(t, err)
+	if assert.NotNil(t, diff) {
+		assert.Equal(t, "0", diff.Attributes["widget.#"].New)
+		assert.True(t, diff.Attributes["layout_json"].NewComputed)
+	}
+}
+
+func TestExpandDashboard_Widgets(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDashboard().Schema, map[string]interface{}{
+		"organization": "new_provider_org",
+		"name":         "API overview",
+		"widget": []interface{}{
+			map[string]interface{}{"type": "stat", "title": "Uptime", "query": "availability"},
+		},
+	})
+
+	dashboard, err := expandDashboard(d)
+	assert.NoError(t, err)
+
+	var layout DashboardLayout
+	assert.NoError(t, json.Unmarshal(dashboard.Layout, &layout))
+	assert.Equal(t, 12, layout.Columns)
+	assert.Equal(t, []DashboardWidget{{Type: "stat", Title: "Uptime", Query: "availability", Width: 6, Height: 4}}, layout.Widgets)
+}
+
+func TestExpandDashboard_LayoutJSON(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDashboard().Schema, map[string]interface{}{
+		"organization": "new_provider_org",
+		"name":         "API overview",
+		"layout_json":  `{"columns":24,"widgets":[],"theme":"dark"}`,
+	})
+
+	dashboard, err := expandDashboard(d)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"columns":24,"widgets":[],"theme":"dark"}`, string(dashboard.Layout))
+}
@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const environmentsPath = "/api/public/provider/environments"
+
+var environmentNamePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// Environment is a deployment stage of a project, such as staging or
+// production.
+type Environment struct {
+	ID           string `json:"id"`
+	Organization string `json:"organization"`
+	ProjectID    string `json:"projectId"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+}
+
+// EnvironmentListResponse is the body returned when listing the environments
+// of an organization.
+type EnvironmentListResponse struct {
+	Environments *[]Environment `json:"Environments"`
+}
+
+// EnvironmentEnvelope is the body returned when creating or updating an
+// environment.
+type EnvironmentEnvelope struct {
+	Environment *Environment `json:"environment"`
+}
+
+func resourceEnvironment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEnvironmentCreate,
+		ReadContext:   resourceEnvironmentRead,
+		UpdateContext: resourceEnvironmentUpdate,
+		DeleteContext: resourceEnvironmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 63),
+					validation.StringMatch(environmentNamePattern, "must contain only lowercase letters, digits and hyphens, and start and end with a letter or digit"),
+				),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"environment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandEnvironment(d *schema.ResourceData) (Environment, error) {
+	organization, ok := d.Get("organization").(string)
+	if !ok {
+		return Environment{}, fmt.Errorf("organization must be a string")
+	}
+	projectID, ok := d.Get("project_id").(string)
+	if !ok {
+		return Environment{}, fmt.Errorf("project_id must be a string")
+	}
+	name, ok := d.Get("name").(string)
+	if !ok {
+		return Environment{}, fmt.Errorf("name must be a string")
+	}
+	description, ok := d.Get("description").(string)
+	if !ok {
+		return Environment{}, fmt.Errorf("description must be a string")
+	}
+	environmentID, ok := d.Get("environment_id").(string)
+	if !ok {
+		return Environment{}, fmt.Errorf("environment_id must be a string")
+	}
+	return Environment{
+		ID:           environmentID,
+		Organization: organization,
+		ProjectID:    projectID,
+		Name:         name,
+		Description:  description,
+	}, nil
+}
+
+func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	environment, err := expandEnvironment(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var envelope EnvironmentEnvelope
+	if diags := config.writeJSON(ctx, http.MethodPut, environmentsPath, "Unable to create environment", environment, &envelope); diags.HasError() {
+		return diags
+	}
+	if envelope.Environment == nil || envelope.Environment.ID == "" {
+		return diag.Errorf("environment ID not found in response")
+	}
+
+	d.SetId(buildID(environment.Organization, envelope.Environment.ID))
+	return resourceEnvironmentRead(ctx, d, meta)
+}
+
+func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/environment_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	org, environmentID := parts[0], parts[1]
+
+	var result EnvironmentListResponse
+	gone, diags := config.readJSON(ctx, organizationListPath(environmentsPath, org), "Unable to read environments", &result)
+	if diags.HasError() {
+		return diags
+	}
+	if gone {
+		log.Printf("[WARN] Organization %q not found, removing environment %q from state", org, environmentID)
+		d.SetId("")
+		return nil
+	}
+	if result.Environments == nil {
+		return diag.Errorf("response does not contain Environments")
+	}
+
+	for _, environment := range *result.Environments {
+		if environment.ID != environmentID {
+			continue
+		}
+		if err := d.Set("organization", org); err != nil {
+			return diag.Errorf("error setting organization: %s", err)
+		}
+		if err := d.Set("environment_id", environment.ID); err != nil {
+			return diag.Errorf("error setting environment_id: %s", err)
+		}
+		if err := d.Set("project_id", environment.ProjectID); err != nil {
+			return diag.Errorf("error setting project_id: %s", err)
+		}
+		if err := d.Set("name", environment.Name); err != nil {
+			return diag.Errorf("error setting name: %s", err)
+		}
+		if err := d.Set("description", environment.Description); err != nil {
+			return diag.Errorf("error setting description: %s", err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] Environment %q not found in organization %q, removing from state", environmentID, org)
+	d.SetId("")
+	return nil
+}
+
+func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	environment, err := expandEnvironment(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := config.writeJSON(ctx, http.MethodPut, environmentsPath, "Unable to update environment", environment, nil); diags.HasError() {
+		return diags
+	}
+	return resourceEnvironmentRead(ctx, d, meta)
+}
+
+func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/environment_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	payload := map[string]string{
+		"id":           parts[1],
+		"organization": parts[0],
+	}
+	if diags := config.deleteJSON(ctx, environmentsPath, "Unable to delete environment", payload); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
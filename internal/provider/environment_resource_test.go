@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccEnvironmentResource(t *testing.T) {
+	token := os.Getenv("EXAMPLE_TOKEN")
+	if token == "" {
+		t.Fatal("EXAMPLE_TOKEN is not set in environment variables")
+	}
+
+	config := func(description string) string {
+		return `
+provider "goliatdashboard" {
+  backend_url = "https://demo.goliat-dashboard.com"
+  token       = "` + token + `"
+}
+
+resource "goliatdashboard_organization" "test_org" {
+  name = "new_provider_org"
+  type = "providerOrganizations"
+}
+
+resource "goliatdashboard_project" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  name         = "Test Project"
+}
+
+resource "goliatdashboard_environment" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  project_id   = goliatdashboard_project.test.id
+  name         = "staging"
+  description  = "` + description + `"
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"goliatdashboard": func() (*schema.Provider, error) { //nolint:unparam
+				return Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("Pre-production"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_environment.test", "name", "staging"),
+					resource.TestCheckResourceAttrSet("goliatdashboard_environment.test", "environment_id"),
+				),
+			},
+			{
+				Config: config("Release candidates"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_environment.test", "description", "Release candidates"),
+				),
+			},
+			{
+				ResourceName:      "goliatdashboard_environment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceEnvironmentRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "new_provider_org", r.URL.Query().Get("organization"))
+		_, _ = w.Write(loadFixture(t, "environment_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceEnvironment().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org/environment_1")
+
+	diags := resourceEnvironmentRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "environment_1", d.Get("environment_id"))
+	assert.Equal(t, "project_1", d.Get("project_id"))
+	assert.Equal(t, "staging", d.Get("name"))
+	assert.Equal(t, "Pre-production", d.Get("description"))
+}
+
+func TestResourceEnvironmentRead_Removed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Environments":[]}`))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceEnvironment().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org/environment_1")
+
+	diags := resourceEnvironmentRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Empty(t, d.Id())
+}
@@ -0,0 +1,338 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const escalationPoliciesPath = "/api/public/provider/escalation-policies"
+
+// EscalationPolicy notifies its rules in order until an alert is
+// acknowledged.
+type EscalationPolicy struct {
+	ID           string           `json:"id"`
+	Organization string           `json:"organization"`
+	Name         string           `json:"name"`
+	Description  string           `json:"description"`
+	Repeat       int              `json:"repeat"`
+	Rules        []EscalationRule `json:"rules"`
+}
+
+// EscalationRule notifies its targets DelayMinutes after the previous rule,
+// or after the alert fires for the first rule.
+type EscalationRule struct {
+	DelayMinutes int      `json:"delayMinutes"`
+	UserIDs      []string `json:"userIds"`
+	TeamIDs      []string `json:"teamIds"`
+	ScheduleIDs  []string `json:"scheduleIds"`
+	ChannelIDs   []string `json:"channelIds"`
+}
+
+// EscalationPolicyListResponse is the body returned when listing the
+// escalation policies of an organization.
+type EscalationPolicyListResponse struct {
+	EscalationPolicies *[]EscalationPolicy `json:"EscalationPolicies"`
+}
+
+// EscalationPolicyEnvelope is the body returned when creating or updating an
+// escalation policy.
+type EscalationPolicyEnvelope struct {
+	EscalationPolicy *EscalationPolicy `json:"escalationPolicy"`
+}
+
+func resourceEscalationPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEscalationPolicyCreate,
+		ReadContext:   resourceEscalationPolicyRead,
+		UpdateContext: resourceEscalationPolicyUpdate,
+		DeleteContext: resourceEscalationPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"repeat": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 9),
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delay_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 1440),
+						},
+						"user_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"team_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"schedule_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"channel_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"policy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: resourceEscalationPolicyCustomizeDiff,
+	}
+}
+
+// resourceEscalationPolicyCustomizeDiff checks that every rule notifies
+// someone. Rules whose targets are not known yet are checked at apply.
+func resourceEscalationPolicyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("rule") {
+		return nil
+	}
+	raw, ok := d.Get("rule").([]interface{})
+	if !ok {
+		return fmt.Errorf("rule must be a list")
+	}
+	unknown := unknownConfigBlocks(d, "rule", "user_ids", "team_ids", "schedule_ids", "channel_ids")
+	for i, rule := range expandEscalationRules(raw) {
+		if unknown[i] {
+			continue
+		}
+		if len(rule.UserIDs)+len(rule.TeamIDs)+len(rule.ScheduleIDs)+len(rule.ChannelIDs) == 0 {
+			return fmt.Errorf("rule.%d: at least one of user_ids, team_ids, schedule_ids and channel_ids must be set", i)
+		}
+	}
+	return nil
+}
+
+func expandEscalationRules(raw []interface{}) []EscalationRule {
+	rules := make([]EscalationRule, 0, len(raw))
+	for _, item := range raw {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		var rule EscalationRule
+		rule.DelayMinutes, _ = m["delay_minutes"].(int)
+		for key, target := range map[string]*[]string{
+			"user_ids":     &rule.UserIDs,
+			"team_ids":     &rule.TeamIDs,
+			"schedule_ids": &rule.ScheduleIDs,
+			"channel_ids":  &rule.ChannelIDs,
+		} {
+			*target = []string{}
+			if set, ok := m[key].(*schema.Set); ok {
+				*target = expandStringSet(set)
+			}
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func flattenEscalationRules(rules []EscalationRule) []interface{} {
+	result := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, map[string]interface{}{
+			"delay_minutes": rule.DelayMinutes,
+			"user_ids":      rule.UserIDs,
+			"team_ids":      rule.TeamIDs,
+			"schedule_ids":  rule.ScheduleIDs,
+			"channel_ids":   rule.ChannelIDs,
+		})
+	}
+	return result
+}
+
+func expandEscalationPolicy(d *schema.ResourceData) (EscalationPolicy, error) {
+	organization, ok := d.Get("organization").(string)
+	if !ok {
+		return EscalationPolicy{}, fmt.Errorf("organization must be a string")
+	}
+	name, ok := d.Get("name").(string)
+	if !ok {
+		return EscalationPolicy{}, fmt.Errorf("name must be a string")
+	}
+	description, ok := d.Get("description").(string)
+	if !ok {
+		return EscalationPolicy{}, fmt.Errorf("description must be a string")
+	}
+	repeat, ok := d.Get("repeat").(int)
+	if !ok {
+		return EscalationPolicy{}, fmt.Errorf("repeat must be an integer")
+	}
+	rules, ok := d.Get("rule").([]interface{})
+	if !ok {
+		return EscalationPolicy{}, fmt.Errorf("rule must be a list")
+	}
+	policyID, ok := d.Get("policy_id").(string)
+	if !ok {
+		return EscalationPolicy{}, fmt.Errorf("policy_id must be a string")
+	}
+	return EscalationPolicy{
+		ID:           policyID,
+		Organization: organization,
+		Name:         name,
+		Description:  description,
+		Repeat:       repeat,
+		Rules:        expandEscalationRules(rules),
+	}, nil
+}
+
+func resourceEscalationPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	policy, err := expandEscalationPolicy(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var envelope EscalationPolicyEnvelope
+	if diags := config.writeJSON(ctx, http.MethodPut, escalationPoliciesPath, "Unable to create escalation policy", policy, &envelope); diags.HasError() {
+		return diags
+	}
+	if envelope.EscalationPolicy == nil || envelope.EscalationPolicy.ID == "" {
+		return diag.Errorf("escalation policy ID not found in response")
+	}
+
+	d.SetId(buildID(policy.Organization, envelope.EscalationPolicy.ID))
+	return resourceEscalationPolicyRead(ctx, d, meta)
+}
+
+func resourceEscalationPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/policy_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	org, policyID := parts[0], parts[1]
+
+	var result EscalationPolicyListResponse
+	gone, diags := config.readJSON(ctx, organizationListPath(escalationPoliciesPath, org), "Unable to read escalation policies", &result)
+	if diags.HasError() {
+		return diags
+	}
+	if gone {
+		log.Printf("[WARN] Organization %q not found, removing escalation policy %q from state", org, policyID)
+		d.SetId("")
+		return nil
+	}
+	if result.EscalationPolicies == nil {
+		return diag.Errorf("response does not contain EscalationPolicies")
+	}
+
+	for _, policy := range *result.EscalationPolicies {
+		if policy.ID != policyID {
+			continue
+		}
+		if err := d.Set("organization", org); err != nil {
+			return diag.Errorf("error setting organization: %s", err)
+		}
+		if err := d.Set("policy_id", policy.ID); err != nil {
+			return diag.Errorf("error setting policy_id: %s", err)
+		}
+		if err := d.Set("name", policy.Name); err != nil {
+			return diag.Errorf("error setting name: %s", err)
+		}
+		if err := d.Set("description", policy.Description); err != nil {
+			return diag.Errorf("error setting description: %s", err)
+		}
+		if err := d.Set("repeat", policy.Repeat); err != nil {
+			return diag.Errorf("error setting repeat: %s", err)
+		}
+		if err := d.Set("rule", flattenEscalationRules(policy.Rules)); err != nil {
+			return diag.Errorf("error setting rule: %s", err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] Escalation policy %q not found in organization %q, removing from state", policyID, org)
+	d.SetId("")
+	return nil
+}
+
+func resourceEscalationPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	policy, err := expandEscalationPolicy(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := config.writeJSON(ctx, http.MethodPut, escalationPoliciesPath, "Unable to update escalation policy", policy, nil); diags.HasError() {
+		return diags
+	}
+	return resourceEscalationPolicyRead(ctx, d, meta)
+}
+
+func resourceEscalationPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/policy_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	payload := map[string]string{
+		"id":           parts[1],
+		"organization": parts[0],
+	}
+	if diags := config.deleteJSON(ctx, escalationPoliciesPath, "Unable to delete escalation policy", payload); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccEscalationPolicyResource(t *testing.T) {
+	token := os.Getenv("EXAMPLE_TOKEN")
+	if token == "" {
+		t.Fatal("EXAMPLE_TOKEN is not set in environment variables")
+	}
+
+	config := func(rules string) string {
+		return `
+provider "goliatdashboard" {
+  backend_url = "https://demo.goliat-dashboard.com"
+  token       = "` + token + `"
+}
+
+resource "goliatdashboard_organization" "test_org" {
+  name = "new_provider_org"
+  type = "providerOrganizations"
+}
+
+resource "goliatdashboard_team" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  name         = "SRE"
+}
+
+resource "goliatdashboard_notification_channel" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  name         = "On-call email"
+  type         = "email"
+
+  email {
+    addresses = ["oncall@example.com"]
+  }
+}
+
+resource "goliatdashboard_escalation_policy" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  name         = "API on-call"
+` + rules + `
+}
+`
+	}
+
+	channelRule := `
+  rule {
+    channel_ids = [goliatdashboard_notification_channel.test.channel_id]
+  }
+`
+	teamRule := `
+  rule {
+    delay_minutes = 15
+    team_ids      = [goliatdashboard_team.test.team_id]
+  }
+`
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"goliatdashboard": func() (*schema.Provider, error) { //nolint:unparam
+				return Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(channelRule + teamRule),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_escalation_policy.test", "rule.#", "2"),
+					resource.TestCheckResourceAttr("goliatdashboard_escalation_policy.test", "rule.1.delay_minutes", "15"),
+					resource.TestCheckResourceAttrSet("goliatdashboard_escalation_policy.test", "policy_id"),
+				),
+			},
+			{
+				// Swapping the rules is a change of order, not a replacement.
+				Config: config(teamRule + channelRule),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_escalation_policy.test", "rule.0.delay_minutes", "15"),
+					resource.TestCheckResourceAttr("goliatdashboard_escalation_policy.test", "rule.1.channel_ids.#", "1"),
+				),
+			},
+			{
+				Config:      config("  rule {\n    delay_minutes = 5\n  }\n"),
+				ExpectError: regexp.MustCompile(`rule.0: at least one of`),
+			},
+			{
+				ResourceName:      "goliatdashboard_escalation_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceEscalationPolicyCustomizeDiff_UnknownTargets(t *testing.T) {
+	config := func(targets map[string]cty.Value) map[string]cty.Value {
+		return map[string]cty.Value{
+			"organization": cty.StringVal("new_provider_org"),
+			"name":         cty.StringVal("Payments"),
+			"rule": cty.TupleVal([]cty.Value{
+				cty.ObjectVal(targets),
+				cty.ObjectVal(map[string]cty.Value{
+					"delay_minutes": cty.NumberIntVal(15),
+					"user_ids":      cty.SetVal([]cty.Value{cty.StringVal("user_1")}),
+				}),
+			}),
+		}
+	}
+
+	// The schedule, channel and team are created in the same apply, so their
+	// IDs are unknown.
+	assert.NoError(t, planResource(t, resourceEscalationPolicy(), config(map[string]cty.Value{
+		"schedule_ids": cty.SetVal([]cty.Value{cty.UnknownVal(cty.String)}),
+		"channel_ids":  cty.SetVal([]cty.Value{cty.UnknownVal(cty.String)}),
+	})))
+	assert.NoError(t, planResource(t, resourceEscalationPolicy(), config(map[string]cty.Value{
+		"team_ids": cty.UnknownVal(cty.Set(cty.String)),
+	})))
+
+	err := planResource(t, resourceEscalationPolicy(), config(map[string]cty.Value{
+		"delay_minutes": cty.NumberIntVal(5),
+	}))
+	assert.ErrorContains(t, err, "rule.0: at least one of user_ids, team_ids, schedule_ids and channel_ids must be set")
+}
+
+func TestResourceEscalationPolicyRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadFixture(t, "escalation_policy_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceEscalationPolicy().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org/policy_1")
+
+	diags := resourceEscalationPolicyRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 2, d.Get("repeat"))
+	assert.Equal(t, 2, d.Get("rule.#"))
+	assert.Equal(t, 0, d.Get("rule.0.delay_minutes"))
+	assert.ElementsMatch(t, []interface{}{"schedule_1"}, d.Get("rule.0.schedule_ids").(*schema.Set).List()) //nolint:forcetypeassert
+	assert.Equal(t, 15, d.Get("rule.1.delay_minutes"))
+	assert.ElementsMatch(t, []interface{}{"user_1", "user_2"}, d.Get("rule.1.user_ids").(*schema.Set).List()) //nolint:forcetypeassert
+	assert.Equal(t, 0, d.Get("rule.1.channel_ids").(*schema.Set).Len())                                       //nolint:forcetypeassert
+}
+
+func TestResourceEscalationPolicyUpdate(t *testing.T) {
+	var sent EscalationPolicy
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			_ = json.NewDecoder(r.Body).Decode(&sent)
+			_, _ = w.Write([]byte(`{"escalationPolicy":{"id":"policy_1"}}`))
+			return
+		}
+		_, _ = w.Write(loadFixture(t, "escalation_policy_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceEscalationPolicy().Schema, map[string]interface{}{
+		"organization": "new_provider_org",
+		"name":         "API on-call",
+		"repeat":       2,
+		"rule": []interface{}{
+			map[string]interface{}{"channel_ids": []interface{}{"channel_1"}, "schedule_ids": []interface{}{"schedule_1"}},
+			map[string]interface{}{"delay_minutes": 15, "user_ids": []interface{}{"user_1", "user_2"}, "team_ids": []interface{}{"team_1"}},
+		},
+	})
+	d.SetId("new_provider_org/policy_1")
+	assert.NoError(t, d.Set("policy_id", "policy_1"))
+
+	diags := resourceEscalationPolicyUpdate(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "policy_1", sent.ID)
+	assert.Len(t, sent.Rules, 2)
+	assert.Equal(t, []string{"channel_1"}, sent.Rules[0].ChannelIDs)
+	assert.Equal(t, []string{}, sent.Rules[0].UserIDs)
+	assert.Equal(t, 15, sent.Rules[1].DelayMinutes)
+	assert.ElementsMatch(t, []string{"user_1", "user_2"}, sent.Rules[1].UserIDs)
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// frameworkProvider serves the features the SDKv2 provider cannot, such as
+// ephemeral resources. It is muxed with Provider() and must declare exactly
+// the same provider schema.
+type frameworkProvider struct{}
+
+var _ fwprovider.ProviderWithEphemeralResources = &frameworkProvider{}
+
+type frameworkProviderModel struct {
+	BackendURL    types.String `tfsdk:"backend_url"`
+	Token         types.String `tfsdk:"token"`
+	DefaultLabels types.Map    `tfsdk:"default_labels"`
+}
+
+func newFrameworkProvider() fwprovider.Provider {
+	return &frameworkProvider{}
+}
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ fwprovider.MetadataRequest, resp *fwprovider.MetadataResponse) {
+	resp.TypeName = "goliatdashboard"
+}
+
+func (p *frameworkProvider) Schema(_ context.Context, _ fwprovider.SchemaRequest, resp *fwprovider.SchemaResponse) {
+	resp.Schema = fwschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"backend_url": fwschema.StringAttribute{
+				Required: true,
+			},
+			"token": fwschema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			"default_labels": fwschema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (p *frameworkProvider) Configure(ctx context.Context, req fwprovider.ConfigureRequest, resp *fwprovider.ConfigureResponse) {
+	var model frameworkProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultLabels := map[string]string{}
+	if !model.DefaultLabels.IsNull() && !model.DefaultLabels.IsUnknown() {
+		resp.Diagnostics.Append(model.DefaultLabels.ElementsAs(ctx, &defaultLabels, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	config := &Config{
+		BackendURL:    model.BackendURL.ValueString(),
+		Token:         model.Token.ValueString(),
+		DefaultLabels: defaultLabels,
+	}
+	resp.EphemeralResourceData = config
+}
+
+func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newSessionTokenEphemeralResource,
+	}
+}
+
+// frameworkDiagnostics converts SDKv2 diagnostics, as returned by the shared
+// request helpers, into framework diagnostics.
+func frameworkDiagnostics(diags diag.Diagnostics) fwdiag.Diagnostics {
+	var result fwdiag.Diagnostics
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			result.AddError(d.Summary, d.Detail)
+		} else {
+			result.AddWarning(d.Summary, d.Detail)
+		}
+	}
+	return result
+}
+
+// durationValidator checks that a string attribute is a Go duration such as
+// "15m" or "1h30m".
+type durationValidator struct{}
+
+var _ validator.String = durationValidator{}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a Go duration string such as \"15m\""
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid duration", err.Error())
+		return
+	}
+	if d <= 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid duration", fmt.Sprintf("expected a positive duration, got %s", d))
+	}
+}
@@ -0,0 +1,595 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const httpChecksPath = "/api/public/provider/http-checks"
+
+const (
+	assertionStatusCode        = "status_code"
+	assertionJSONPathEquals    = "json_path_equals"
+	assertionHeaderContains    = "header_contains"
+	assertionResponseTimeUnder = "response_time_under"
+)
+
+// HTTPCheck is a scripted HTTP request whose response is checked against a
+// list of assertions.
+type HTTPCheck struct {
+	ID              string               `json:"id"`
+	Organization    string               `json:"organization"`
+	ProjectID       string               `json:"projectId"`
+	Name            string               `json:"name"`
+	URL             string               `json:"url"`
+	Method          string               `json:"method"`
+	Headers         map[string]string    `json:"headers"`
+	Body            string               `json:"body"`
+	FollowRedirects bool                 `json:"followRedirects"`
+	TLSVerify       bool                 `json:"tlsVerify"`
+	IntervalSeconds int                  `json:"intervalSeconds"`
+	Assertions      []HTTPCheckAssertion `json:"assertions"`
+}
+
+// HTTPCheckAssertion is a single condition the response must meet. Which
+// fields are used depends on Type.
+type HTTPCheckAssertion struct {
+	Type         string `json:"type"`
+	StatusCode   int    `json:"statusCode,omitempty"`
+	Path         string `json:"path,omitempty"`
+	Header       string `json:"header,omitempty"`
+	Value        string `json:"value,omitempty"`
+	Milliseconds int    `json:"milliseconds,omitempty"`
+}
+
+// HTTPCheckListResponse is the body returned when listing the HTTP checks of
+// an organization.
+type HTTPCheckListResponse struct {
+	HTTPChecks *[]HTTPCheck `json:"HttpChecks"`
+}
+
+// HTTPCheckEnvelope is the body returned when creating or updating an HTTP
+// check.
+type HTTPCheckEnvelope struct {
+	HTTPCheck *HTTPCheck `json:"httpCheck"`
+}
+
+func resourceHTTPCheck() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHTTPCheckCreate,
+		ReadContext:   resourceHTTPCheckRead,
+		UpdateContext: resourceHTTPCheckUpdate,
+		DeleteContext: resourceHTTPCheckDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      http.MethodGet,
+				ValidateFunc: validation.StringInSlice([]string{http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodOptions}, false),
+			},
+			"headers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"body": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"follow_redirects": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"tls_verify": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"interval_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntAtLeast(10),
+			},
+			"assertion": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{assertionStatusCode, assertionJSONPathEquals, assertionHeaderContains, assertionResponseTimeUnder}, false),
+						},
+						"status_code": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(100, 599),
+						},
+						"path": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateJSONPath,
+						},
+						"header": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"milliseconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"check_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: resourceHTTPCheckCustomizeDiff,
+	}
+}
+
+// resourceHTTPCheckCustomizeDiff checks that every assertion sets the fields
+// its type needs, and no others. Assertions with unknown fields are checked
+// once their values are known.
+func resourceHTTPCheckCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("assertion") {
+		return nil
+	}
+	raw, ok := d.Get("assertion").([]interface{})
+	if !ok {
+		return fmt.Errorf("assertion must be a list")
+	}
+	unknown := unknownConfigBlocks(d, "assertion")
+	for i, assertion := range expandHTTPCheckAssertions(raw) {
+		if unknown[i] {
+			continue
+		}
+		if err := validateHTTPCheckAssertion(assertion); err != nil {
+			return fmt.Errorf("assertion.%d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// validateHTTPCheckAssertion returns an error if a is missing a field its
+// type requires or sets one that does not apply to it.
+func validateHTTPCheckAssertion(a HTTPCheckAssertion) error {
+	set := map[string]bool{
+		"status_code":  a.StatusCode != 0,
+		"path":         a.Path != "",
+		"header":       a.Header != "",
+		"value":        a.Value != "",
+		"milliseconds": a.Milliseconds != 0,
+	}
+
+	var required []string
+	switch a.Type {
+	case assertionStatusCode:
+		required = []string{"status_code"}
+	case assertionJSONPathEquals:
+		required = []string{"path", "value"}
+	case assertionHeaderContains:
+		required = []string{"header", "value"}
+	case assertionResponseTimeUnder:
+		required = []string{"milliseconds"}
+	}
+
+	for _, field := range required {
+		if !set[field] {
+			return fmt.Errorf("%s must be set for %q assertions", field, a.Type)
+		}
+		delete(set, field)
+	}
+	for _, field := range []string{"status_code", "path", "header", "value", "milliseconds"} {
+		if set[field] {
+			return fmt.Errorf("%s cannot be set for %q assertions", field, a.Type)
+		}
+	}
+	return nil
+}
+
+var jsonPathName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*`)
+
+// validateJSONPath is a schema.SchemaValidateFunc that rejects malformed
+// JSONPath expressions such as "$.items[0" or "items.id".
+func validateJSONPath(v interface{}, k string) ([]string, []error) {
+	expr, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if err := parseJSONPath(expr); err != nil {
+		return nil, []error{fmt.Errorf("%s: invalid JSONPath %q: %w", k, expr, err)}
+	}
+	return nil, nil
+}
+
+// parseJSONPath checks the syntax of a JSONPath expression: a "$" followed by
+// dot-notation members, recursive descent, wildcards and bracketed indexes,
+// slices, quoted names or filters.
+func parseJSONPath(expr string) error {
+	if !strings.HasPrefix(expr, "$") {
+		return fmt.Errorf("must start with \"$\"")
+	}
+
+	rest := expr[1:]
+	for rest != "" {
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			rest = rest[2:]
+			if rest == "" {
+				return fmt.Errorf("expected a member name or selector after \"..\"")
+			}
+			if strings.HasPrefix(rest, "[") {
+				continue
+			}
+			next, err := parseJSONPathMember(rest)
+			if err != nil {
+				return err
+			}
+			rest = next
+		case strings.HasPrefix(rest, "."):
+			next, err := parseJSONPathMember(rest[1:])
+			if err != nil {
+				return err
+			}
+			rest = next
+		case strings.HasPrefix(rest, "["):
+			end := jsonPathBracketEnd(rest)
+			if end < 0 {
+				return fmt.Errorf("unterminated \"[\"")
+			}
+			if err := parseJSONPathSelector(rest[1:end]); err != nil {
+				return err
+			}
+			rest = rest[end+1:]
+		default:
+			return fmt.Errorf("unexpected %q", rest[:1])
+		}
+	}
+	return nil
+}
+
+// parseJSONPathMember consumes a member name or "*" and returns the rest.
+func parseJSONPathMember(s string) (string, error) {
+	if strings.HasPrefix(s, "*") {
+		return s[1:], nil
+	}
+	name := jsonPathName.FindString(s)
+	if name == "" {
+		return "", fmt.Errorf("expected a member name after \".\"")
+	}
+	return s[len(name):], nil
+}
+
+// jsonPathBracketEnd returns the index of the "]" closing the "[" that s
+// starts with, skipping quoted strings and nested brackets, or -1.
+func jsonPathBracketEnd(s string) int {
+	depth := 0
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+var (
+	jsonPathIndexes = regexp.MustCompile(`^\s*-?\d+\s*(,\s*-?\d+\s*)*$`)
+	jsonPathSlice   = regexp.MustCompile(`^\s*(-?\d+)?\s*:\s*(-?\d+)?\s*(:\s*-?\d+\s*)?$`)
+	jsonPathNames   = regexp.MustCompile(`^\s*('([^'\\]|\\.)*'|"([^"\\]|\\.)*")\s*(,\s*('([^'\\]|\\.)*'|"([^"\\]|\\.)*")\s*)*$`)
+)
+
+// parseJSONPathSelector checks the contents of a bracketed selector.
+func parseJSONPathSelector(s string) error {
+	trimmed := strings.TrimSpace(s)
+	switch {
+	case trimmed == "*":
+		return nil
+	case strings.HasPrefix(trimmed, "?"):
+		filter := strings.TrimSpace(trimmed[1:])
+		if len(filter) < 3 || filter[0] != '(' || filter[len(filter)-1] != ')' {
+			return fmt.Errorf("filter %q must be wrapped in \"?(...)\"", s)
+		}
+		if strings.Count(filter, "(") != strings.Count(filter, ")") {
+			return fmt.Errorf("unbalanced parentheses in filter %q", s)
+		}
+		return nil
+	case jsonPathIndexes.MatchString(trimmed), jsonPathSlice.MatchString(trimmed), jsonPathNames.MatchString(trimmed):
+		return nil
+	}
+	return fmt.Errorf("invalid selector [%s]", s)
+}
+
+func expandHTTPCheckAssertions(raw []interface{}) []HTTPCheckAssertion {
+	assertions := make([]HTTPCheckAssertion, 0, len(raw))
+	for _, item := range raw {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		var a HTTPCheckAssertion
+		a.Type, _ = m["type"].(string)
+		a.StatusCode, _ = m["status_code"].(int)
+		a.Path, _ = m["path"].(string)
+		a.Header, _ = m["header"].(string)
+		a.Value, _ = m["value"].(string)
+		a.Milliseconds, _ = m["milliseconds"].(int)
+		assertions = append(assertions, a)
+	}
+	return assertions
+}
+
+func flattenHTTPCheckAssertions(assertions []HTTPCheckAssertion) []interface{} {
+	result := make([]interface{}, 0, len(assertions))
+	for _, a := range assertions {
+		result = append(result, map[string]interface{}{
+			"type":         a.Type,
+			"status_code":  a.StatusCode,
+			"path":         a.Path,
+			"header":       a.Header,
+			"value":        a.Value,
+			"milliseconds": a.Milliseconds,
+		})
+	}
+	return result
+}
+
+func expandHTTPCheck(d *schema.ResourceData) (HTTPCheck, error) {
+	organization, ok := d.Get("organization").(string)
+	if !ok {
+		return HTTPCheck{}, fmt.Errorf("organization must be a string")
+	}
+	projectID, ok := d.Get("project_id").(string)
+	if !ok {
+		return HTTPCheck{}, fmt.Errorf("project_id must be a string")
+	}
+	name, ok := d.Get("name").(string)
+	if !ok {
+		return HTTPCheck{}, fmt.Errorf("name must be a string")
+	}
+	url, ok := d.Get("url").(string)
+	if !ok {
+		return HTTPCheck{}, fmt.Errorf("url must be a string")
+	}
+	method, ok := d.Get("method").(string)
+	if !ok {
+		return HTTPCheck{}, fmt.Errorf("method must be a string")
+	}
+	headers, ok := d.Get("headers").(map[string]interface{})
+	if !ok {
+		return HTTPCheck{}, fmt.Errorf("headers must be a map")
+	}
+	body, ok := d.Get("body").(string)
+	if !ok {
+		return HTTPCheck{}, fmt.Errorf("body must be a string")
+	}
+	followRedirects, ok := d.Get("follow_redirects").(bool)
+	if !ok {
+		return HTTPCheck{}, fmt.Errorf("follow_redirects must be a bool")
+	}
+	tlsVerify, ok := d.Get("tls_verify").(bool)
+	if !ok {
+		return HTTPCheck{}, fmt.Errorf("tls_verify must be a bool")
+	}
+	interval, ok := d.Get("interval_seconds").(int)
+	if !ok {
+		return HTTPCheck{}, fmt.Errorf("interval_seconds must be an integer")
+	}
+	assertions, ok := d.Get("assertion").([]interface{})
+	if !ok {
+		return HTTPCheck{}, fmt.Errorf("assertion must be a list")
+	}
+	checkID, ok := d.Get("check_id").(string)
+	if !ok {
+		return HTTPCheck{}, fmt.Errorf("check_id must be a string")
+	}
+	return HTTPCheck{
+		ID:              checkID,
+		Organization:    organization,
+		ProjectID:       projectID,
+		Name:            name,
+		URL:             url,
+		Method:          method,
+		Headers:         expandStringMap(headers),
+		Body:            body,
+		FollowRedirects: followRedirects,
+		TLSVerify:       tlsVerify,
+		IntervalSeconds: interval,
+		Assertions:      expandHTTPCheckAssertions(assertions),
+	}, nil
+}
+
+func resourceHTTPCheckCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	check, err := expandHTTPCheck(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var envelope HTTPCheckEnvelope
+	if diags := config.writeJSON(ctx, http.MethodPut, httpChecksPath, "Unable to create HTTP check", check, &envelope); diags.HasError() {
+		return diags
+	}
+	if envelope.HTTPCheck == nil || envelope.HTTPCheck.ID == "" {
+		return diag.Errorf("HTTP check ID not found in response")
+	}
+
+	d.SetId(buildID(check.Organization, envelope.HTTPCheck.ID))
+	return resourceHTTPCheckRead(ctx, d, meta)
+}
+
+func resourceHTTPCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/check_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	org, checkID := parts[0], parts[1]
+
+	var result HTTPCheckListResponse
+	gone, diags := config.readJSON(ctx, organizationListPath(httpChecksPath, org), "Unable to read HTTP checks", &result)
+	if diags.HasError() {
+		return diags
+	}
+	if gone {
+		log.Printf("[WARN] Organization %q not found, removing HTTP check %q from state", org, checkID)
+		d.SetId("")
+		return nil
+	}
+	if result.HTTPChecks == nil {
+		return diag.Errorf("response does not contain HttpChecks")
+	}
+
+	for _, check := range *result.HTTPChecks {
+		if check.ID != checkID {
+			continue
+		}
+		if err := d.Set("organization", org); err != nil {
+			return diag.Errorf("error setting organization: %s", err)
+		}
+		if err := d.Set("check_id", check.ID); err != nil {
+			return diag.Errorf("error setting check_id: %s", err)
+		}
+		if err := d.Set("project_id", check.ProjectID); err != nil {
+			return diag.Errorf("error setting project_id: %s", err)
+		}
+		if err := d.Set("name", check.Name); err != nil {
+			return diag.Errorf("error setting name: %s", err)
+		}
+		if err := d.Set("url", check.URL); err != nil {
+			return diag.Errorf("error setting url: %s", err)
+		}
+		if err := d.Set("method", check.Method); err != nil {
+			return diag.Errorf("error setting method: %s", err)
+		}
+		if err := d.Set("headers", check.Headers); err != nil {
+			return diag.Errorf("error setting headers: %s", err)
+		}
+		if err := d.Set("body", check.Body); err != nil {
+			return diag.Errorf("error setting body: %s", err)
+		}
+		if err := d.Set("follow_redirects", check.FollowRedirects); err != nil {
+			return diag.Errorf("error setting follow_redirects: %s", err)
+		}
+		if err := d.Set("tls_verify", check.TLSVerify); err != nil {
+			return diag.Errorf("error setting tls_verify: %s", err)
+		}
+		if err := d.Set("interval_seconds", check.IntervalSeconds); err != nil {
+			return diag.Errorf("error setting interval_seconds: %s", err)
+		}
+		if err := d.Set("assertion", flattenHTTPCheckAssertions(check.Assertions)); err != nil {
+			return diag.Errorf("error setting assertion: %s", err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] HTTP check %q not found in organization %q, removing from state", checkID, org)
+	d.SetId("")
+	return nil
+}
+
+func resourceHTTPCheckUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	check, err := expandHTTPCheck(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := config.writeJSON(ctx, http.MethodPut, httpChecksPath, "Unable to update HTTP check", check, nil); diags.HasError() {
+		return diags
+	}
+	return resourceHTTPCheckRead(ctx, d, meta)
+}
+
+func resourceHTTPCheckDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/check_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	payload := map[string]string{
+		"id":           parts[1],
+		"organization": parts[0],
+	}
+	if diags := config.deleteJSON(ctx, httpChecksPath, "Unable to delete HTTP check", payload); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccHTTPCheckResource(t *testing.T) {
+	token := os.Getenv("EXAMPLE_TOKEN")
+	if token == "" {
+		t.Fatal("EXAMPLE_TOKEN is not set in environment variables")
+	}
+
+	config := func(path string) string {
+		return `
+provider "goliatdashboard" {
+  backend_url = "https://demo.goliat-dashboard.com"
+  token       = "` + token + `"
+}
+
+resource "goliatdashboard_organization" "test_org" {
+  name = "new_provider_org"
+  type = "providerOrganizations"
+}
+
+resource "goliatdashboard_project" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  name         = "Test Project"
+}
+
+resource "goliatdashboard_http_check" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  project_id   = goliatdashboard_project.test.id
+  name         = "Health"
+  url          = "https://demo.goliat-dashboard.com/api/health"
+
+  assertion {
+    type        = "status_code"
+    status_code = 200
+  }
+
+  assertion {
+    type  = "json_path_equals"
+    path  = "` + path + `"
+    value = "ok"
+  }
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"goliatdashboard": func() (*schema.Provider, error) { //nolint:unparam
+				return Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      config("$.status["),
+				ExpectError: regexp.MustCompile(`invalid JSONPath`),
+				PlanOnly:    true,
+			},
+			{
+				Config: config("$.status"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_http_check.test", "method", "GET"),
+					resource.TestCheckResourceAttr("goliatdashboard_http_check.test", "assertion.#", "2"),
+					resource.TestCheckResourceAttrSet("goliatdashboard_http_check.test", "check_id"),
+				),
+			},
+			{
+				ResourceName:      "goliatdashboard_http_check.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestParseJSONPath(t *testing.T) {
+	valid := []string{
+		"$",
+		"$.status",
+		"$.data.items[0].id",
+		"$..id",
+		"$..[0]",
+		"$.items[*].name",
+		"$['first name']",
+		"$.items[0,2]",
+		"$.items[-1:]",
+		"$.items[?(@.price < 10)]",
+		"$.items[?(@.tags[0] == 'a]b')]",
+	}
+	for _, expr := range valid {
+		assert.NoError(t, parseJSONPath(expr), expr)
+	}
+
+	invalid := []string{
+		"",
+		"status",
+		"$.",
+		"$..",
+		"$.items..",
+		"$...",
+		"$.items[0",
+		"$.items[]",
+		"$.items[a]",
+		"$.items[?@.price]",
+		"$.1st",
+		"$ .status",
+	}
+	for _, expr := range invalid {
+		assert.Error(t, parseJSONPath(expr), expr)
+	}
+
+	assert.ErrorContains(t, parseJSONPath("$.items.."), `after ".."`)
+}
+
+func TestValidateHTTPCheckAssertion(t *testing.T) {
+	assert.NoError(t, validateHTTPCheckAssertion(HTTPCheckAssertion{Type: assertionStatusCode, StatusCode: 200}))
+	assert.NoError(t, validateHTTPCheckAssertion(HTTPCheckAssertion{Type: assertionHeaderContains, Header: "Cache-Control", Value: "no-store"}))
+
+	err := validateHTTPCheckAssertion(HTTPCheckAssertion{Type: assertionJSONPathEquals, Path: "$.ok"})
+	assert.ErrorContains(t, err, `value must be set for "json_path_equals" assertions`)
+
+	err = validateHTTPCheckAssertion(HTTPCheckAssertion{Type: assertionResponseTimeUnder, Milliseconds: 300, StatusCode: 200})
+	assert.ErrorContains(t, err, `status_code cannot be set for "response_time_under" assertions`)
+}
+
+func TestResourceHTTPCheckCustomizeDiff_UnknownAssertion(t *testing.T) {
+	config := func(value cty.Value) map[string]cty.Value {
+		return map[string]cty.Value{
+			"organization": cty.StringVal("new_provider_org"),
+			"project_id":   cty.StringVal("prj_1"),
+			"name":         cty.StringVal("api-health"),
+			"url":          cty.StringVal("https://api.example.com/health"),
+			"assertion": cty.TupleVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"type":   cty.StringVal(assertionHeaderContains),
+					"header": cty.StringVal("X-Release"),
+					"value":  value,
+				}),
+				cty.ObjectVal(map[string]cty.Value{
+					"type":        cty.StringVal(assertionStatusCode),
+					"status_code": cty.NumberIntVal(200),
+				}),
+			}),
+		}
+	}
+
+	// The value comes from another resource and is only known at apply.
+	assert.NoError(t, planResource(t, resourceHTTPCheck(), config(cty.UnknownVal(cty.String))))
+
+	err := planResource(t, resourceHTTPCheck(), config(cty.NullVal(cty.String)))
+	assert.ErrorContains(t, err, `assertion.0: value must be set for "header_contains" assertions`)
+}
+
+func TestResourceHTTPCheckRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadFixture(t, "http_check_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceHTTPCheck().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org/check_1")
+
+	diags := resourceHTTPCheckRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "POST", d.Get("method"))
+	assert.Equal(t, false, d.Get("follow_redirects"))
+	assert.Equal(t, "application/json", d.Get("headers.Content-Type"))
+	assert.Equal(t, 3, d.Get("assertion.#"))
+	assert.Equal(t, "$.session.active", d.Get("assertion.1.path"))
+	assert.Equal(t, 500, d.Get("assertion.2.milliseconds"))
+}
@@ -0,0 +1,370 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const incidentsPath = "/api/public/provider/incidents"
+
+// incidentStatuses are the stages of an incident, in the order they usually
+// happen.
+var incidentStatuses = []string{"investigating", "identified", "monitoring", "resolved"}
+
+var incidentSeverities = []string{"critical", "major", "minor"}
+
+// Incident is an outage or degradation of one or more services. StartedAt
+// and ResolvedAt are set by the backend when the incident is opened and
+// resolved.
+type Incident struct {
+	ID                 string           `json:"id"`
+	Organization       string           `json:"organization"`
+	Title              string           `json:"title"`
+	Severity           string           `json:"severity"`
+	Status             string           `json:"status"`
+	AffectedComponents []string         `json:"affectedComponents"`
+	Updates            []IncidentUpdate `json:"updates"`
+	StartedAt          string           `json:"startedAt,omitempty"`
+	ResolvedAt         string           `json:"resolvedAt,omitempty"`
+}
+
+// IncidentUpdate is an entry of the incident timeline. PostedAt is set by
+// the backend.
+type IncidentUpdate struct {
+	Status   string `json:"status"`
+	Message  string `json:"message"`
+	PostedAt string `json:"postedAt,omitempty"`
+}
+
+// IncidentListResponse is the body returned when listing the incidents of an
+// organization.
+type IncidentListResponse struct {
+	Incidents *[]Incident `json:"Incidents"`
+}
+
+// IncidentEnvelope is the body returned when creating or updating an
+// incident.
+type IncidentEnvelope struct {
+	Incident *Incident `json:"incident"`
+}
+
+func resourceIncident() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIncidentCreate,
+		ReadContext:   resourceIncidentRead,
+		UpdateContext: resourceIncidentUpdate,
+		DeleteContext: resourceIncidentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"severity": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(incidentSeverities, false),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "investigating",
+				ValidateFunc: validation.StringInSlice(incidentStatuses, false),
+			},
+			"affected_components": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(incidentStatuses, false),
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"posted_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"incident_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"started_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resolved_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: resourceIncidentCustomizeDiff,
+	}
+}
+
+// resourceIncidentCustomizeDiff checks that the latest timeline update
+// agrees with the status of the incident, and refreshes resolved_at when the
+// incident is resolved or reopened.
+func resourceIncidentCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("status") {
+		return nil
+	}
+	status, ok := d.Get("status").(string)
+	if !ok {
+		return fmt.Errorf("status must be a string")
+	}
+	if d.NewValueKnown("update") {
+		raw, ok := d.Get("update").([]interface{})
+		if !ok {
+			return fmt.Errorf("update must be a list")
+		}
+		updates := expandIncidentUpdates(raw)
+		unknown := unknownConfigBlocks(d, "update", "status")
+		if len(updates) == 0 || !unknown[len(updates)-1] {
+			if err := validateIncidentTimeline(status, updates); err != nil {
+				return err
+			}
+		}
+	}
+	if d.HasChange("status") {
+		old, _ := d.GetChange("status")
+		if old == "resolved" || status == "resolved" {
+			return d.SetNewComputed("resolved_at")
+		}
+	}
+	return nil
+}
+
+func validateIncidentTimeline(status string, updates []IncidentUpdate) error {
+	if len(updates) == 0 {
+		return nil
+	}
+	last := updates[len(updates)-1]
+	if last.Status != status {
+		return fmt.Errorf("update.%d: status %q of the latest update must match the incident status %q", len(updates)-1, last.Status, status)
+	}
+	return nil
+}
+
+func expandIncidentUpdates(raw []interface{}) []IncidentUpdate {
+	updates := make([]IncidentUpdate, 0, len(raw))
+	for _, item := range raw {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		var u IncidentUpdate
+		u.Status, _ = m["status"].(string)
+		u.Message, _ = m["message"].(string)
+		updates = append(updates, u)
+	}
+	return updates
+}
+
+func flattenIncidentUpdates(updates []IncidentUpdate) []interface{} {
+	result := make([]interface{}, 0, len(updates))
+	for _, u := range updates {
+		result = append(result, map[string]interface{}{
+			"status":    u.Status,
+			"message":   u.Message,
+			"posted_at": u.PostedAt,
+		})
+	}
+	return result
+}
+
+func expandIncident(d *schema.ResourceData) (Incident, error) {
+	organization, ok := d.Get("organization").(string)
+	if !ok {
+		return Incident{}, fmt.Errorf("organization must be a string")
+	}
+	title, ok := d.Get("title").(string)
+	if !ok {
+		return Incident{}, fmt.Errorf("title must be a string")
+	}
+	severity, ok := d.Get("severity").(string)
+	if !ok {
+		return Incident{}, fmt.Errorf("severity must be a string")
+	}
+	status, ok := d.Get("status").(string)
+	if !ok {
+		return Incident{}, fmt.Errorf("status must be a string")
+	}
+	components, ok := d.Get("affected_components").(*schema.Set)
+	if !ok {
+		return Incident{}, fmt.Errorf("affected_components must be a set")
+	}
+	updates, ok := d.Get("update").([]interface{})
+	if !ok {
+		return Incident{}, fmt.Errorf("update must be a list")
+	}
+	incidentID, ok := d.Get("incident_id").(string)
+	if !ok {
+		return Incident{}, fmt.Errorf("incident_id must be a string")
+	}
+	return Incident{
+		ID:                 incidentID,
+		Organization:       organization,
+		Title:              title,
+		Severity:           severity,
+		Status:             status,
+		AffectedComponents: expandStringSet(components),
+		Updates:            expandIncidentUpdates(updates),
+	}, nil
+}
+
+func resourceIncidentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	incident, err := expandIncident(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var envelope IncidentEnvelope
+	if diags := config.writeJSON(ctx, http.MethodPut, incidentsPath, "Unable to create incident", incident, &envelope); diags.HasError() {
+		return diags
+	}
+	if envelope.Incident == nil || envelope.Incident.ID == "" {
+		return diag.Errorf("incident ID not found in response")
+	}
+
+	d.SetId(buildID(incident.Organization, envelope.Incident.ID))
+	return resourceIncidentRead(ctx, d, meta)
+}
+
+func resourceIncidentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/incident_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	org, incidentID := parts[0], parts[1]
+
+	var result IncidentListResponse
+	gone, diags := config.readJSON(ctx, organizationListPath(incidentsPath, org), "Unable to read incidents", &result)
+	if diags.HasError() {
+		return diags
+	}
+	if gone {
+		log.Printf("[WARN] Organization %q not found, removing incident %q from state", org, incidentID)
+		d.SetId("")
+		return nil
+	}
+	if result.Incidents == nil {
+		return diag.Errorf("response does not contain Incidents")
+	}
+
+	for _, incident := range *result.Incidents {
+		if incident.ID != incidentID {
+			continue
+		}
+		if err := d.Set("organization", org); err != nil {
+			return diag.Errorf("error setting organization: %s", err)
+		}
+		if err := d.Set("incident_id", incident.ID); err != nil {
+			return diag.Errorf("error setting incident_id: %s", err)
+		}
+		if err := d.Set("title", incident.Title); err != nil {
+			return diag.Errorf("error setting title: %s", err)
+		}
+		if err := d.Set("severity", incident.Severity); err != nil {
+			return diag.Errorf("error setting severity: %s", err)
+		}
+		if err := d.Set("status", incident.Status); err != nil {
+			return diag.Errorf("error setting status: %s", err)
+		}
+		if err := d.Set("affected_components", incident.AffectedComponents); err != nil {
+			return diag.Errorf("error setting affected_components: %s", err)
+		}
+		if err := d.Set("update", flattenIncidentUpdates(incident.Updates)); err != nil {
+			return diag.Errorf("error setting update: %s", err)
+		}
+		if err := d.Set("started_at", incident.StartedAt); err != nil {
+			return diag.Errorf("error setting started_at: %s", err)
+		}
+		if err := d.Set("resolved_at", incident.ResolvedAt); err != nil {
+			return diag.Errorf("error setting resolved_at: %s", err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] Incident %q not found in organization %q, removing from state", incidentID, org)
+	d.SetId("")
+	return nil
+}
+
+func resourceIncidentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	incident, err := expandIncident(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := config.writeJSON(ctx, http.MethodPut, incidentsPath, "Unable to update incident", incident, nil); diags.HasError() {
+		return diags
+	}
+	return resourceIncidentRead(ctx, d, meta)
+}
+
+func resourceIncidentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/incident_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	payload := map[string]string{
+		"id":           parts[1],
+		"organization": parts[0],
+	}
+	if diags := config.deleteJSON(ctx, incidentsPath, "Unable to delete incident", payload); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccIncidentResource(t *testing.T) {
+	token := os.Getenv("EXAMPLE_TOKEN")
+	if token == "" {
+		t.Fatal("EXAMPLE_TOKEN is not set in environment variables")
+	}
+
+	config := func(status, updates string) string {
+		return `
+provider "goliatdashboard" {
+  backend_url = "https://demo.goliat-dashboard.com"
+  token       = "` + token + `"
+}
+
+resource "goliatdashboard_organization" "test_org" {
+  name = "new_provider_org"
+  type = "providerOrganizations"
+}
+
+resource "goliatdashboard_incident" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  title        = "Elevated API error rate"
+  severity     = "major"
+  status       = "` + status + `"
+` + updates + `
+}
+`
+	}
+
+	investigating := `
+  update {
+    status  = "investigating"
+    message = "We are looking into elevated error rates."
+  }
+`
+	resolved := investigating + `
+  update {
+    status  = "resolved"
+    message = "A faulty deploy was rolled back."
+  }
+`
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"goliatdashboard": func() (*schema.Provider, error) { //nolint:unparam
+				return Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("investigating", investigating),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_incident.test", "update.#", "1"),
+					resource.TestCheckResourceAttrSet("goliatdashboard_incident.test", "update.0.posted_at"),
+					resource.TestCheckResourceAttrSet("goliatdashboard_incident.test", "started_at"),
+					resource.TestCheckResourceAttr("goliatdashboard_incident.test", "resolved_at", ""),
+				),
+			},
+			{
+				Config:      config("resolved", investigating),
+				ExpectError: regexp.MustCompile(`must match the incident status`),
+			},
+			{
+				Config: config("resolved", resolved),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_incident.test", "update.#", "2"),
+					resource.TestCheckResourceAttrSet("goliatdashboard_incident.test", "resolved_at"),
+				),
+			},
+			{
+				ResourceName:      "goliatdashboard_incident.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestValidateIncidentTimeline(t *testing.T) {
+	updates := []IncidentUpdate{
+		{Status: "investigating", Message: "Looking into it."},
+		{Status: "monitoring", Message: "A fix is out."},
+	}
+	assert.NoError(t, validateIncidentTimeline("resolved", nil))
+	assert.NoError(t, validateIncidentTimeline("monitoring", updates))
+	assert.ErrorContains(t, validateIncidentTimeline("resolved", updates), `update.1: status "monitoring" of the latest update must match the incident status "resolved"`)
+}
+
+func TestResourceIncidentCustomizeDiff_UnknownUpdateStatus(t *testing.T) {
+	config := func(status cty.Value) map[string]cty.Value {
+		return map[string]cty.Value{
+			"organization": cty.StringVal("new_provider_org"),
+			"title":        cty.StringVal("Elevated API errors"),
+			"severity":     cty.StringVal("major"),
+			"status":       cty.StringVal("identified"),
+			"update": cty.TupleVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"status":  status,
+				"message": cty.StringVal("Rolling back the release."),
+			})}),
+		}
+	}
+
+	assert.NoError(t, planResource(t, resourceIncident(), config(cty.UnknownVal(cty.String))))
+
+	err := planResource(t, resourceIncident(), config(cty.StringVal("investigating")))
+	assert.ErrorContains(t, err, `must match the incident status "identified"`)
+}
+
+func TestResourceIncidentRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadFixture(t, "incident_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceIncident().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org/incident_1")
+
+	diags := resourceIncidentRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "resolved", d.Get("status"))
+	assert.Equal(t, 2, d.Get("update.#"))
+	assert.Equal(t, "investigating", d.Get("update.0.status"))
+	assert.Equal(t, "2026-09-02T10:40:00Z", d.Get("update.1.posted_at"))
+	assert.Equal(t, "2026-09-02T10:40:00Z", d.Get("resolved_at"))
+	assert.ElementsMatch(t, []interface{}{"service_1"}, d.Get("affected_components").(*schema.Set).List()) //nolint:forcetypeassert
+}
+
+func TestResourceIncidentUpdate(t *testing.T) {
+	var sent map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			_ = json.NewDecoder(r.Body).Decode(&sent)
+			_, _ = w.Write([]byte(`{"incident":{"id":"incident_1"}}`))
+			return
+		}
+		_, _ = w.Write(loadFixture(t, "incident_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceIncident().Schema, map[string]interface{}{
+		"organization":        "new_provider_org",
+		"title":               "Elevated API error rate",
+		"severity":            "major",
+		"status":              "resolved",
+		"affected_components": []interface{}{"service_1"},
+		"update": []interface{}{
+			map[string]interface{}{"status": "investigating", "message": "We are looking into elevated error rates."},
+			map[string]interface{}{"status": "resolved", "message": "A faulty deploy was rolled back."},
+		},
+	})
+	d.SetId("new_provider_org/incident_1")
+	assert.NoError(t, d.Set("incident_id", "incident_1"))
+
+	diags := resourceIncidentUpdate(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "incident_1", sent["id"])
+	assert.NotContains(t, sent, "startedAt")
+	assert.NotContains(t, sent, "resolvedAt")
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"status": "investigating", "message": "We are looking into elevated error rates."},
+		map[string]interface{}{"status": "resolved", "message": "A faulty deploy was rolled back."},
+	}, sent["updates"])
+}
@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceIncidents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIncidentsRead,
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(incidentStatuses, false),
+			},
+			"started_after": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"started_before": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"incidents": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"incident_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"affected_components": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"update": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"message": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"posted_at": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"started_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resolved_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// incidentFilter selects incidents by status and by when they started. Zero
+// values match every incident.
+type incidentFilter struct {
+	Status        string
+	StartedAfter  time.Time
+	StartedBefore time.Time
+}
+
+// matches reports whether incident passes the filter. Incidents without a
+// valid start time never match a time range.
+func (f incidentFilter) matches(incident Incident) bool {
+	if f.Status != "" && incident.Status != f.Status {
+		return false
+	}
+	if f.StartedAfter.IsZero() && f.StartedBefore.IsZero() {
+		return true
+	}
+	startedAt, err := time.Parse(time.RFC3339, incident.StartedAt)
+	if err != nil {
+		return false
+	}
+	if !f.StartedAfter.IsZero() && startedAt.Before(f.StartedAfter) {
+		return false
+	}
+	if !f.StartedBefore.IsZero() && !startedAt.Before(f.StartedBefore) {
+		return false
+	}
+	return true
+}
+
+func expandIncidentFilter(d *schema.ResourceData) (incidentFilter, error) {
+	var filter incidentFilter
+	status, ok := d.Get("status").(string)
+	if !ok {
+		return filter, fmt.Errorf("status must be a string")
+	}
+	filter.Status = status
+	for key, target := range map[string]*time.Time{
+		"started_after":  &filter.StartedAfter,
+		"started_before": &filter.StartedBefore,
+	} {
+		v, ok := d.Get(key).(string)
+		if !ok {
+			return filter, fmt.Errorf("%s must be a string", key)
+		}
+		if v == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return filter, fmt.Errorf("%s: %s", key, err)
+		}
+		*target = t
+	}
+	if !filter.StartedAfter.IsZero() && !filter.StartedBefore.IsZero() && !filter.StartedAfter.Before(filter.StartedBefore) {
+		return filter, fmt.Errorf("started_after must be before started_before")
+	}
+	return filter, nil
+}
+
+func flattenIncidents(incidents []Incident) []interface{} {
+	result := make([]interface{}, 0, len(incidents))
+	for _, incident := range incidents {
+		result = append(result, map[string]interface{}{
+			"incident_id":         incident.ID,
+			"title":               incident.Title,
+			"severity":            incident.Severity,
+			"status":              incident.Status,
+			"affected_components": incident.AffectedComponents,
+			"update":              flattenIncidentUpdates(incident.Updates),
+			"started_at":          incident.StartedAt,
+			"resolved_at":         incident.ResolvedAt,
+		})
+	}
+	return result
+}
+
+func dataSourceIncidentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	org, ok := d.Get("organization").(string)
+	if !ok {
+		return diag.Errorf("organization must be a string")
+	}
+	filter, err := expandIncidentFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result IncidentListResponse
+	gone, diags := config.readJSON(ctx, organizationListPath(incidentsPath, org), "Unable to read incidents", &result)
+	if diags.HasError() {
+		return diags
+	}
+	if gone {
+		return diag.Errorf("organization %q not found", org)
+	}
+	if result.Incidents == nil {
+		return diag.Errorf("response does not contain Incidents")
+	}
+
+	incidents := make([]Incident, 0, len(*result.Incidents))
+	for _, incident := range *result.Incidents {
+		if filter.matches(incident) {
+			incidents = append(incidents, incident)
+		}
+	}
+
+	if err := d.Set("incidents", flattenIncidents(incidents)); err != nil {
+		return diag.Errorf("error setting incidents: %s", err)
+	}
+	d.SetId(org)
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccIncidentsDataSource(t *testing.T) {
+	token := os.Getenv("EXAMPLE_TOKEN")
+	if token == "" {
+		t.Fatal("EXAMPLE_TOKEN is not set in environment variables")
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"goliatdashboard": func() (*schema.Provider, error) { //nolint:unparam
+				return Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "goliatdashboard" {
+  backend_url = "https://demo.goliat-dashboard.com"
+  token       = "` + token + `"
+}
+
+resource "goliatdashboard_organization" "test_org" {
+  name = "new_provider_org"
+  type = "providerOrganizations"
+}
+
+resource "goliatdashboard_incident" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  title        = "Slow dashboard loads"
+  severity     = "minor"
+  status       = "identified"
+}
+
+data "goliatdashboard_incidents" "identified" {
+  organization = goliatdashboard_organization.test_org.name
+  status       = "identified"
+
+  depends_on = [goliatdashboard_incident.test]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.goliatdashboard_incidents.identified", "incidents.*", map[string]string{
+						"title":  "Slow dashboard loads",
+						"status": "identified",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceIncidentsRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "new_provider_org", r.URL.Query().Get("organization"))
+		_, _ = w.Write(loadFixture(t, "incident_list.json"))
+	}))
+	defer server.Close()
+
+	cases := map[string]struct {
+		filter map[string]interface{}
+		want   []string
+	}{
+		"no filter":     {filter: map[string]interface{}{}, want: []string{"incident_1", "incident_2", "incident_3"}},
+		"status":        {filter: map[string]interface{}{"status": "monitoring"}, want: []string{"incident_2"}},
+		"started after": {filter: map[string]interface{}{"started_after": "2026-10-01T06:30:00Z"}, want: []string{"incident_2", "incident_3"}},
+		"time range": {
+			filter: map[string]interface{}{"started_after": "2026-09-01T00:00:00Z", "started_before": "2026-10-01T06:30:00Z"},
+			want:   []string{"incident_1"},
+		},
+		"status and time range": {
+			filter: map[string]interface{}{"status": "investigating", "started_after": "2026-10-01T00:00:00Z"},
+			want:   []string{"incident_3"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			raw := map[string]interface{}{"organization": "new_provider_org"}
+			for k, v := range tc.filter {
+				raw[k] = v
+			}
+			d := schema.TestResourceDataRaw(t, dataSourceIncidents().Schema, raw)
+
+			diags := dataSourceIncidentsRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+			assert.False(t, diags.HasError())
+			assert.Equal(t, "new_provider_org", d.Id())
+
+			var got []string
+			for _, item := range d.Get("incidents").([]interface{}) { //nolint:forcetypeassert
+				got = append(got, item.(map[string]interface{})["incident_id"].(string)) //nolint:forcetypeassert
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestDataSourceIncidentsRead_InvalidRange(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceIncidents().Schema, map[string]interface{}{
+		"organization":   "new_provider_org",
+		"started_after":  "2026-10-01T00:00:00Z",
+		"started_before": "2026-09-01T00:00:00Z",
+	})
+
+	diags := dataSourceIncidentsRead(context.Background(), d, &Config{BackendURL: "http://127.0.0.1:0", Token: "test"})
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "started_after must be before started_before")
+}
@@ -0,0 +1,476 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	// Embed the time zone database so timezone validation does not depend on
+	// the zoneinfo files of the machine running Terraform.
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const maintenanceWindowsPath = "/api/public/provider/maintenance-windows"
+
+const (
+	maintenanceWindowScheduled = "scheduled"
+	maintenanceWindowExpired   = "expired"
+)
+
+// MaintenanceWindow silences alerts for a set of projects and services,
+// either once between StartTime and EndTime or on every Recurrence for
+// Duration.
+type MaintenanceWindow struct {
+	ID           string   `json:"id"`
+	Organization string   `json:"organization"`
+	Name         string   `json:"name"`
+	StartTime    string   `json:"startTime,omitempty"`
+	EndTime      string   `json:"endTime,omitempty"`
+	Recurrence   string   `json:"recurrence,omitempty"`
+	Duration     string   `json:"duration,omitempty"`
+	Timezone     string   `json:"timezone"`
+	ProjectIDs   []string `json:"projectIds"`
+	ServiceIDs   []string `json:"serviceIds"`
+	Status       string   `json:"status,omitempty"`
+}
+
+// MaintenanceWindowListResponse is the body returned when listing the
+// maintenance windows of an organization.
+type MaintenanceWindowListResponse struct {
+	MaintenanceWindows *[]MaintenanceWindow `json:"MaintenanceWindows"`
+}
+
+// MaintenanceWindowEnvelope is the body returned when creating or updating a
+// maintenance window.
+type MaintenanceWindowEnvelope struct {
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow"`
+}
+
+// status returns the state of the window at now. A one-off window whose end
+// time has passed is expired regardless of what the backend reports.
+func (w MaintenanceWindow) status(now time.Time) string {
+	if w.Recurrence == "" && w.EndTime != "" {
+		if endTime, err := time.Parse(time.RFC3339, w.EndTime); err == nil && !now.Before(endTime) {
+			return maintenanceWindowExpired
+		}
+	}
+	if w.Status != "" {
+		return w.Status
+	}
+	return maintenanceWindowScheduled
+}
+
+func resourceMaintenanceWindow() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMaintenanceWindowCreate,
+		ReadContext:   resourceMaintenanceWindowRead,
+		UpdateContext: resourceMaintenanceWindowUpdate,
+		DeleteContext: resourceMaintenanceWindowDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"start_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"start_time", "recurrence"},
+				RequiredWith:     []string{"end_time"},
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339,
+			},
+			"end_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				RequiredWith:     []string{"start_time"},
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339,
+			},
+			"recurrence": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"duration"},
+				ValidateFunc: validateCronExpression,
+			},
+			"duration": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				RequiredWith:     []string{"recurrence"},
+				ValidateFunc:     validateDuration,
+				DiffSuppressFunc: suppressEquivalentDuration,
+			},
+			"timezone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "UTC",
+				ValidateFunc: validateTimezone,
+			},
+			"project_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				AtLeastOneOf: []string{"project_ids", "service_ids"},
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+			"service_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"window_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: resourceMaintenanceWindowCustomizeDiff,
+	}
+}
+
+// resourceMaintenanceWindowCustomizeDiff checks that a one-off window ends
+// after it starts.
+func resourceMaintenanceWindowCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("start_time") || !d.NewValueKnown("end_time") {
+		return nil
+	}
+	start, ok := d.Get("start_time").(string)
+	if !ok {
+		return fmt.Errorf("start_time must be a string")
+	}
+	end, ok := d.Get("end_time").(string)
+	if !ok {
+		return fmt.Errorf("end_time must be a string")
+	}
+	if start == "" || end == "" {
+		return nil
+	}
+	startTime, err := time.Parse(time.RFC3339, start)
+	if err != nil {
+		return fmt.Errorf("start_time: %w", err)
+	}
+	endTime, err := time.Parse(time.RFC3339, end)
+	if err != nil {
+		return fmt.Errorf("end_time: %w", err)
+	}
+	if !endTime.After(startTime) {
+		return fmt.Errorf("end_time (%s) must be after start_time (%s)", end, start)
+	}
+	return nil
+}
+
+// validateTimezone is a schema.SchemaValidateFunc that accepts IANA time
+// zone names such as "Europe/Madrid".
+func validateTimezone(v interface{}, k string) ([]string, []error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if _, err := time.LoadLocation(s); err != nil || s == "" || s == "Local" {
+		return nil, []error{fmt.Errorf("%s: %q is not an IANA time zone such as \"Europe/Madrid\"", k, s)}
+	}
+	return nil, nil
+}
+
+// cronFieldBounds are the allowed ranges of the minute, hour, day of month,
+// month and day of week fields of a cron expression.
+var cronFieldBounds = [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+
+var cronFieldNames = [5]string{"minute", "hour", "day of month", "month", "day of week"}
+
+// validateCronExpression is a schema.SchemaValidateFunc that accepts
+// five-field cron expressions such as "0 2 * * SUN" and the @hourly, @daily,
+// @weekly, @monthly and @yearly shorthands.
+func validateCronExpression(v interface{}, k string) ([]string, []error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if err := parseCronExpression(s); err != nil {
+		return nil, []error{fmt.Errorf("%s: invalid cron expression %q: %w", k, s, err)}
+	}
+	return nil, nil
+}
+
+func parseCronExpression(expr string) error {
+	switch expr {
+	case "@hourly", "@daily", "@weekly", "@monthly", "@yearly", "@annually":
+		return nil
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return fmt.Errorf("expected 5 fields, got %d", len(fields))
+	}
+	for i, field := range fields {
+		if err := parseCronField(field, i); err != nil {
+			return fmt.Errorf("%s field: %w", cronFieldNames[i], err)
+		}
+	}
+	return nil
+}
+
+var (
+	cronMonthNames = map[string]int{"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6, "JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12}
+	cronDayNames   = map[string]int{"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6}
+)
+
+// parseCronField checks one comma-separated field of a cron expression.
+func parseCronField(field string, index int) error {
+	low, high := cronFieldBounds[index][0], cronFieldBounds[index][1]
+	value := func(s string) (int, error) {
+		upper := strings.ToUpper(s)
+		if n, ok := cronMonthNames[upper]; ok && index == 3 {
+			return n, nil
+		}
+		if n, ok := cronDayNames[upper]; ok && index == 4 {
+			return n, nil
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not a number", s)
+		}
+		if n < low || n > high {
+			return 0, fmt.Errorf("%d is outside %d-%d", n, low, high)
+		}
+		return n, nil
+	}
+
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step, hasStep := strings.Cut(part, "/")
+		if hasStep {
+			if n, err := strconv.Atoi(step); err != nil || n <= 0 {
+				return fmt.Errorf("invalid step %q", step)
+			}
+		}
+		if rangePart == "*" {
+			continue
+		}
+		from, to, isRange := strings.Cut(rangePart, "-")
+		start, err := value(from)
+		if err != nil {
+			return err
+		}
+		if !isRange {
+			continue
+		}
+		end, err := value(to)
+		if err != nil {
+			return err
+		}
+		if end < start {
+			return fmt.Errorf("range %q is reversed", rangePart)
+		}
+	}
+	return nil
+}
+
+func expandMaintenanceWindow(d *schema.ResourceData) (MaintenanceWindow, error) {
+	organization, ok := d.Get("organization").(string)
+	if !ok {
+		return MaintenanceWindow{}, fmt.Errorf("organization must be a string")
+	}
+	name, ok := d.Get("name").(string)
+	if !ok {
+		return MaintenanceWindow{}, fmt.Errorf("name must be a string")
+	}
+	startTime, ok := d.Get("start_time").(string)
+	if !ok {
+		return MaintenanceWindow{}, fmt.Errorf("start_time must be a string")
+	}
+	endTime, ok := d.Get("end_time").(string)
+	if !ok {
+		return MaintenanceWindow{}, fmt.Errorf("end_time must be a string")
+	}
+	recurrence, ok := d.Get("recurrence").(string)
+	if !ok {
+		return MaintenanceWindow{}, fmt.Errorf("recurrence must be a string")
+	}
+	duration, ok := d.Get("duration").(string)
+	if !ok {
+		return MaintenanceWindow{}, fmt.Errorf("duration must be a string")
+	}
+	timezone, ok := d.Get("timezone").(string)
+	if !ok {
+		return MaintenanceWindow{}, fmt.Errorf("timezone must be a string")
+	}
+	projectIDs, ok := d.Get("project_ids").(*schema.Set)
+	if !ok {
+		return MaintenanceWindow{}, fmt.Errorf("project_ids must be a set")
+	}
+	serviceIDs, ok := d.Get("service_ids").(*schema.Set)
+	if !ok {
+		return MaintenanceWindow{}, fmt.Errorf("service_ids must be a set")
+	}
+	windowID, ok := d.Get("window_id").(string)
+	if !ok {
+		return MaintenanceWindow{}, fmt.Errorf("window_id must be a string")
+	}
+	return MaintenanceWindow{
+		ID:           windowID,
+		Organization: organization,
+		Name:         name,
+		StartTime:    startTime,
+		EndTime:      endTime,
+		Recurrence:   recurrence,
+		Duration:     duration,
+		Timezone:     timezone,
+		ProjectIDs:   expandStringSet(projectIDs),
+		ServiceIDs:   expandStringSet(serviceIDs),
+	}, nil
+}
+
+func resourceMaintenanceWindowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	window, err := expandMaintenanceWindow(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var envelope MaintenanceWindowEnvelope
+	if diags := config.writeJSON(ctx, http.MethodPut, maintenanceWindowsPath, "Unable to create maintenance window", window, &envelope); diags.HasError() {
+		return diags
+	}
+	if envelope.MaintenanceWindow == nil || envelope.MaintenanceWindow.ID == "" {
+		return diag.Errorf("maintenance window ID not found in response")
+	}
+
+	d.SetId(buildID(window.Organization, envelope.MaintenanceWindow.ID))
+	return resourceMaintenanceWindowRead(ctx, d, meta)
+}
+
+func resourceMaintenanceWindowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/window_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	org, windowID := parts[0], parts[1]
+
+	var result MaintenanceWindowListResponse
+	gone, diags := config.readJSON(ctx, organizationListPath(maintenanceWindowsPath, org), "Unable to read maintenance windows", &result)
+	if diags.HasError() {
+		return diags
+	}
+	if gone {
+		log.Printf("[WARN] Organization %q not found, removing maintenance window %q from state", org, windowID)
+		d.SetId("")
+		return nil
+	}
+	if result.MaintenanceWindows == nil {
+		return diag.Errorf("response does not contain MaintenanceWindows")
+	}
+
+	for _, window := range *result.MaintenanceWindows {
+		if window.ID != windowID {
+			continue
+		}
+		if err := d.Set("organization", org); err != nil {
+			return diag.Errorf("error setting organization: %s", err)
+		}
+		if err := d.Set("window_id", window.ID); err != nil {
+			return diag.Errorf("error setting window_id: %s", err)
+		}
+		if err := d.Set("name", window.Name); err != nil {
+			return diag.Errorf("error setting name: %s", err)
+		}
+		if err := d.Set("start_time", window.StartTime); err != nil {
+			return diag.Errorf("error setting start_time: %s", err)
+		}
+		if err := d.Set("end_time", window.EndTime); err != nil {
+			return diag.Errorf("error setting end_time: %s", err)
+		}
+		if err := d.Set("recurrence", window.Recurrence); err != nil {
+			return diag.Errorf("error setting recurrence: %s", err)
+		}
+		if err := d.Set("duration", window.Duration); err != nil {
+			return diag.Errorf("error setting duration: %s", err)
+		}
+		if err := d.Set("timezone", window.Timezone); err != nil {
+			return diag.Errorf("error setting timezone: %s", err)
+		}
+		if err := d.Set("project_ids", window.ProjectIDs); err != nil {
+			return diag.Errorf("error setting project_ids: %s", err)
+		}
+		if err := d.Set("service_ids", window.ServiceIDs); err != nil {
+			return diag.Errorf("error setting service_ids: %s", err)
+		}
+		// An ended window stays in state and is reported through status, so
+		// it does not trigger a replacement on the next plan.
+		if err := d.Set("status", window.status(time.Now())); err != nil {
+			return diag.Errorf("error setting status: %s", err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] Maintenance window %q not found in organization %q, removing from state", windowID, org)
+	d.SetId("")
+	return nil
+}
+
+func resourceMaintenanceWindowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	window, err := expandMaintenanceWindow(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := config.writeJSON(ctx, http.MethodPut, maintenanceWindowsPath, "Unable to update maintenance window", window, nil); diags.HasError() {
+		return diags
+	}
+	return resourceMaintenanceWindowRead(ctx, d, meta)
+}
+
+func resourceMaintenanceWindowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/window_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	payload := map[string]string{
+		"id":           parts[1],
+		"organization": parts[0],
+	}
+	if diags := config.deleteJSON(ctx, maintenanceWindowsPath, "Unable to delete maintenance window", payload); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
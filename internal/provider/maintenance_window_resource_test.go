@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccMaintenanceWindowResource(t *testing.T) {
+	token := os.Getenv("EXAMPLE_TOKEN")
+	if token == "" {
+		t.Fatal("EXAMPLE_TOKEN is not set in environment variables")
+	}
+
+	config := func(duration string) string {
+		return `
+provider "goliatdashboard" {
+  backend_url = "https://demo.goliat-dashboard.com"
+  token       = "` + token + `"
+}
+
+resource "goliatdashboard_organization" "test_org" {
+  name = "new_provider_org"
+  type = "providerOrganizations"
+}
+
+resource "goliatdashboard_project" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  name         = "Test Project"
+}
+
+resource "goliatdashboard_maintenance_window" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  name         = "Weekly patching"
+  recurrence   = "0 2 * * SUN"
+  duration     = "` + duration + `"
+  timezone     = "Europe/Madrid"
+  project_ids  = [goliatdashboard_project.test.id]
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"goliatdashboard": func() (*schema.Provider, error) { //nolint:unparam
+				return Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("2h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_maintenance_window.test", "timezone", "Europe/Madrid"),
+					resource.TestCheckResourceAttrSet("goliatdashboard_maintenance_window.test", "window_id"),
+				),
+			},
+			{
+				Config: config("3h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_maintenance_window.test", "duration", "3h"),
+				),
+			},
+			{
+				ResourceName:      "goliatdashboard_maintenance_window.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestParseCronExpression(t *testing.T) {
+	for _, expr := range []string{"0 2 * * SUN", "*/15 * * * *", "30 1 1,15 * MON-FRI", "0 0 1 jan *", "@weekly"} {
+		assert.NoError(t, parseCronExpression(expr), expr)
+	}
+	for _, expr := range []string{"", "0 2 * *", "60 * * * *", "0 24 * * *", "0 0 0 * *", "0 0 * * FRI-MON", "*/0 * * * *", "@sometimes"} {
+		assert.Error(t, parseCronExpression(expr), expr)
+	}
+}
+
+func TestValidateTimezone(t *testing.T) {
+	_, errs := validateTimezone("Europe/Madrid", "timezone")
+	assert.Empty(t, errs)
+	_, errs = validateTimezone("UTC", "timezone")
+	assert.Empty(t, errs)
+
+	for _, tz := range []string{"", "Local", "Mars/Olympus", "CEST+1"} {
+		_, errs = validateTimezone(tz, "timezone")
+		assert.NotEmpty(t, errs, tz)
+	}
+}
+
+func TestMaintenanceWindowStatus(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	ended := MaintenanceWindow{EndTime: "2025-05-01T00:00:00Z", Status: "scheduled"}
+	assert.Equal(t, maintenanceWindowExpired, ended.status(now))
+
+	upcoming := MaintenanceWindow{EndTime: "2025-07-01T00:00:00Z"}
+	assert.Equal(t, maintenanceWindowScheduled, upcoming.status(now))
+
+	recurring := MaintenanceWindow{Recurrence: "@weekly", Status: "active"}
+	assert.Equal(t, "active", recurring.status(now))
+}
+
+func TestResourceMaintenanceWindowRead_Expired(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadFixture(t, "maintenance_window_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceMaintenanceWindow().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org/window_1")
+
+	diags := resourceMaintenanceWindowRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "new_provider_org/window_1", d.Id())
+	assert.Equal(t, maintenanceWindowExpired, d.Get("status"))
+	assert.Equal(t, "2020-03-01T03:00:00Z", d.Get("end_time"))
+}
+
+func TestResourceMaintenanceWindowDiff_ExpiredIsInformational(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadFixture(t, "maintenance_window_list.json"))
+	}))
+	defer server.Close()
+
+	r := resourceMaintenanceWindow()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	d.SetId("new_provider_org/window_1")
+	diags := resourceMaintenanceWindowRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, maintenanceWindowExpired, d.Get("status"))
+	state := d.State()
+
+	block := r.CoreConfigSchema()
+	raw, err := block.CoerceValue(cty.ObjectVal(map[string]cty.Value{
+		"organization": cty.StringVal("new_provider_org"),
+		"name":         cty.StringVal("Database upgrade"),
+		"start_time":   cty.StringVal("2020-03-01T01:00:00Z"),
+		"end_time":     cty.StringVal("2020-03-01T03:00:00Z"),
+		"project_ids":  cty.SetVal([]cty.Value{cty.StringVal("project_1")}),
+	}))
+	assert.NoError(t, err)
+	state.RawConfig = raw
+
+	// status only reports that the window has ended; the unchanged
+	// configuration plans neither an update nor a replacement.
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigShimmed(raw, block), nil)
+	assert.NoError(t, err)
+	assert.Nil(t, diff)
+}
+
+func TestResourceMaintenanceWindowRead_Recurring(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadFixture(t, "maintenance_window_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceMaintenanceWindow().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org/window_2")
+
+	diags := resourceMaintenanceWindowRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "0 2 * * SUN", d.Get("recurrence"))
+	assert.Equal(t, "active", d.Get("status"))
+	assert.Equal(t, []string{"service_1", "service_2"}, expandStringSet(d.Get("service_ids").(*schema.Set))) //nolint:forcetypeassert
+}
@@ -0,0 +1,421 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	notificationChannelsPath    = "/api/public/provider/notification-channels"
+	notificationChannelTestPath = notificationChannelsPath + "/test"
+)
+
+var notificationChannelTypes = []string{"email", "slack", "webhook"}
+
+// NotificationChannel is a destination for alerts. Exactly one of the
+// settings blocks is set, matching Type.
+type NotificationChannel struct {
+	ID           string                  `json:"id"`
+	Organization string                  `json:"organization"`
+	Name         string                  `json:"name"`
+	Type         string                  `json:"type"`
+	Email        *EmailChannelSettings   `json:"email,omitempty"`
+	Slack        *SlackChannelSettings   `json:"slack,omitempty"`
+	Webhook      *WebhookChannelSettings `json:"webhook,omitempty"`
+}
+
+// EmailChannelSettings lists the recipients of an email channel.
+type EmailChannelSettings struct {
+	Addresses []string `json:"addresses"`
+}
+
+// SlackChannelSettings holds the Slack incoming webhook. The backend never
+// returns WebhookURL.
+type SlackChannelSettings struct {
+	WebhookURL string `json:"webhookUrl,omitempty"`
+	Channel    string `json:"channel"`
+}
+
+// WebhookChannelSettings describes a generic webhook. The backend never
+// returns Secret.
+type WebhookChannelSettings struct {
+	URL     string            `json:"url"`
+	Method  string            `json:"method"`
+	Headers map[string]string `json:"headers"`
+	Secret  string            `json:"secret,omitempty"`
+}
+
+// NotificationChannelListResponse is the body returned when listing the
+// notification channels of an organization.
+type NotificationChannelListResponse struct {
+	NotificationChannels *[]NotificationChannel `json:"NotificationChannels"`
+}
+
+// NotificationChannelEnvelope is the body returned when creating or updating
+// a notification channel.
+type NotificationChannelEnvelope struct {
+	NotificationChannel *NotificationChannel `json:"notificationChannel"`
+}
+
+func resourceNotificationChannel() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNotificationChannelCreate,
+		ReadContext:   resourceNotificationChannelRead,
+		UpdateContext: resourceNotificationChannelUpdate,
+		DeleteContext: resourceNotificationChannelDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(notificationChannelTypes, false),
+			},
+			"email": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: notificationChannelTypes,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"addresses": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"slack": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"webhook_url_wo": {
+							Type:         schema.TypeString,
+							Required:     true,
+							WriteOnly:    true,
+							Sensitive:    true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+						"webhook_url_wo_version": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"channel": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"webhook": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+						"method": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      http.MethodPost,
+							ValidateFunc: validation.StringInSlice([]string{http.MethodPost, http.MethodPut}, false),
+						},
+						"headers": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"secret_wo": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							WriteOnly: true,
+							Sensitive: true,
+						},
+						"secret_wo_version": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"send_test_on_create": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"channel_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: resourceNotificationChannelCustomizeDiff,
+	}
+}
+
+// resourceNotificationChannelCustomizeDiff checks that the settings block
+// matches type.
+func resourceNotificationChannelCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("type") {
+		return nil
+	}
+	channelType, ok := d.Get("type").(string)
+	if !ok {
+		return fmt.Errorf("type must be a string")
+	}
+	for _, t := range notificationChannelTypes {
+		if t == channelType || !d.NewValueKnown(t) {
+			continue
+		}
+		if blocks, ok := d.Get(t).([]interface{}); ok && len(blocks) > 0 {
+			return fmt.Errorf("a %q block cannot be used with type %q; use a %q block instead", t, channelType, channelType)
+		}
+	}
+	return nil
+}
+
+func expandNotificationChannel(d *schema.ResourceData) (NotificationChannel, error) {
+	organization, ok := d.Get("organization").(string)
+	if !ok {
+		return NotificationChannel{}, fmt.Errorf("organization must be a string")
+	}
+	name, ok := d.Get("name").(string)
+	if !ok {
+		return NotificationChannel{}, fmt.Errorf("name must be a string")
+	}
+	channelType, ok := d.Get("type").(string)
+	if !ok {
+		return NotificationChannel{}, fmt.Errorf("type must be a string")
+	}
+	channelID, ok := d.Get("channel_id").(string)
+	if !ok {
+		return NotificationChannel{}, fmt.Errorf("channel_id must be a string")
+	}
+	channel := NotificationChannel{
+		ID:           channelID,
+		Organization: organization,
+		Name:         name,
+		Type:         channelType,
+	}
+
+	if m := firstBlock(d.Get("email")); m != nil {
+		addresses, ok := m["addresses"].(*schema.Set)
+		if !ok {
+			return NotificationChannel{}, fmt.Errorf("email.0.addresses must be a set")
+		}
+		channel.Email = &EmailChannelSettings{Addresses: expandStringSet(addresses)}
+	}
+	if m := firstBlock(d.Get("slack")); m != nil {
+		webhookURL, err := getWriteOnlyString(d, cty.GetAttrPath("slack").IndexInt(0).GetAttr("webhook_url_wo"))
+		if err != nil {
+			return NotificationChannel{}, err
+		}
+		channel.Slack = &SlackChannelSettings{WebhookURL: webhookURL}
+		channel.Slack.Channel, _ = m["channel"].(string)
+	}
+	if m := firstBlock(d.Get("webhook")); m != nil {
+		headers, ok := m["headers"].(map[string]interface{})
+		if !ok {
+			return NotificationChannel{}, fmt.Errorf("webhook.0.headers must be a map")
+		}
+		secret, err := getWriteOnlyString(d, cty.GetAttrPath("webhook").IndexInt(0).GetAttr("secret_wo"))
+		if err != nil {
+			return NotificationChannel{}, err
+		}
+		channel.Webhook = &WebhookChannelSettings{Headers: expandStringMap(headers), Secret: secret}
+		channel.Webhook.URL, _ = m["url"].(string)
+		channel.Webhook.Method, _ = m["method"].(string)
+	}
+	return channel, nil
+}
+
+// flattenNotificationChannelSettings converts the settings returned by the
+// backend into blocks. Secrets are write-only and never stored; only their
+// versions are carried over from the current state.
+func flattenNotificationChannelSettings(d *schema.ResourceData, channel NotificationChannel) (email, slack, webhook []interface{}) {
+	if channel.Email != nil {
+		email = []interface{}{map[string]interface{}{
+			"addresses": channel.Email.Addresses,
+		}}
+	}
+	if channel.Slack != nil {
+		slack = []interface{}{map[string]interface{}{
+			"webhook_url_wo_version": d.Get("slack.0.webhook_url_wo_version"),
+			"channel":                channel.Slack.Channel,
+		}}
+	}
+	if channel.Webhook != nil {
+		webhook = []interface{}{map[string]interface{}{
+			"url":               channel.Webhook.URL,
+			"method":            channel.Webhook.Method,
+			"headers":           channel.Webhook.Headers,
+			"secret_wo_version": d.Get("webhook.0.secret_wo_version"),
+		}}
+	}
+	return email, slack, webhook
+}
+
+func resourceNotificationChannelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	channel, err := expandNotificationChannel(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var envelope NotificationChannelEnvelope
+	if diags := config.writeJSON(ctx, http.MethodPut, notificationChannelsPath, "Unable to create notification channel", channel, &envelope); diags.HasError() {
+		return diags
+	}
+	if envelope.NotificationChannel == nil || envelope.NotificationChannel.ID == "" {
+		return diag.Errorf("notification channel ID not found in response")
+	}
+
+	d.SetId(buildID(channel.Organization, envelope.NotificationChannel.ID))
+
+	var diags diag.Diagnostics
+	if sendTest, ok := d.Get("send_test_on_create").(bool); ok && sendTest {
+		payload := map[string]string{
+			"id":           envelope.NotificationChannel.ID,
+			"organization": channel.Organization,
+		}
+		// The channel exists at this point, so a failed test is reported
+		// without tainting it.
+		for _, warning := range config.writeJSON(ctx, http.MethodPost, notificationChannelTestPath, "Unable to send test notification", payload, nil) {
+			warning.Severity = diag.Warning
+			diags = append(diags, warning)
+		}
+	}
+	return append(diags, resourceNotificationChannelRead(ctx, d, meta)...)
+}
+
+func resourceNotificationChannelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/channel_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	org, channelID := parts[0], parts[1]
+
+	var result NotificationChannelListResponse
+	gone, diags := config.readJSON(ctx, organizationListPath(notificationChannelsPath, org), "Unable to read notification channels", &result)
+	if diags.HasError() {
+		return diags
+	}
+	if gone {
+		log.Printf("[WARN] Organization %q not found, removing notification channel %q from state", org, channelID)
+		d.SetId("")
+		return nil
+	}
+	if result.NotificationChannels == nil {
+		return diag.Errorf("response does not contain NotificationChannels")
+	}
+
+	for _, channel := range *result.NotificationChannels {
+		if channel.ID != channelID {
+			continue
+		}
+		email, slack, webhook := flattenNotificationChannelSettings(d, channel)
+		if err := d.Set("organization", org); err != nil {
+			return diag.Errorf("error setting organization: %s", err)
+		}
+		if err := d.Set("channel_id", channel.ID); err != nil {
+			return diag.Errorf("error setting channel_id: %s", err)
+		}
+		if err := d.Set("name", channel.Name); err != nil {
+			return diag.Errorf("error setting name: %s", err)
+		}
+		if err := d.Set("type", channel.Type); err != nil {
+			return diag.Errorf("error setting type: %s", err)
+		}
+		if err := d.Set("email", email); err != nil {
+			return diag.Errorf("error setting email: %s", err)
+		}
+		if err := d.Set("slack", slack); err != nil {
+			return diag.Errorf("error setting slack: %s", err)
+		}
+		if err := d.Set("webhook", webhook); err != nil {
+			return diag.Errorf("error setting webhook: %s", err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] Notification channel %q not found in organization %q, removing from state", channelID, org)
+	d.SetId("")
+	return nil
+}
+
+func resourceNotificationChannelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	channel, err := expandNotificationChannel(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := config.writeJSON(ctx, http.MethodPut, notificationChannelsPath, "Unable to update notification channel", channel, nil); diags.HasError() {
+		return diags
+	}
+	return resourceNotificationChannelRead(ctx, d, meta)
+}
+
+func resourceNotificationChannelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/channel_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	payload := map[string]string{
+		"id":           parts[1],
+		"organization": parts[0],
+	}
+	if diags := config.deleteJSON(ctx, notificationChannelsPath, "Unable to delete notification channel", payload); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
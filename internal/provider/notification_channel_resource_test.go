@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccNotificationChannelResource(t *testing.T) {
+	token := os.Getenv("EXAMPLE_TOKEN")
+	if token == "" {
+		t.Fatal("EXAMPLE_TOKEN is not set in environment variables")
+	}
+
+	config := func(name string) string {
+		return `
+provider "goliatdashboard" {
+  backend_url = "https://demo.goliat-dashboard.com"
+  token       = "` + token + `"
+}
+
+resource "goliatdashboard_organization" "test_org" {
+  name = "new_provider_org"
+  type = "providerOrganizations"
+}
+
+resource "goliatdashboard_notification_channel" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  name         = "` + name + `"
+  type         = "email"
+
+  email {
+    addresses = ["oncall@goliat-dashboard.com"]
+  }
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"goliatdashboard": func() (*schema.Provider, error) { //nolint:unparam
+				return Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("On-call"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_notification_channel.test", "email.0.addresses.#", "1"),
+					resource.TestCheckResourceAttrSet("goliatdashboard_notification_channel.test", "channel_id"),
+				),
+			},
+			{
+				Config: config("Primary on-call"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_notification_channel.test", "name", "Primary on-call"),
+				),
+			},
+			{
+				ResourceName:            "goliatdashboard_notification_channel.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"send_test_on_create"},
+			},
+		},
+	})
+}
+
+func TestResourceNotificationChannelRead_KeepsSecretVersions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadFixture(t, "notification_channel_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceNotificationChannel().Schema, map[string]interface{}{
+		"organization": "new_provider_org",
+		"name":         "Incident bridge",
+		"type":         "webhook",
+		"webhook": []interface{}{
+			map[string]interface{}{"url": "https://hooks.example.com/goliat", "secret_wo_version": 2},
+		},
+	})
+	d.SetId("new_provider_org/channel_2")
+
+	diags := resourceNotificationChannelRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 2, d.Get("webhook.0.secret_wo_version"))
+	assert.Equal(t, "goliat", d.Get("webhook.0.headers.X-Source"))
+	assert.Equal(t, 0, d.Get("slack.#"))
+}
+
+func TestResourceNotificationChannelCreate_SendTest(t *testing.T) {
+	var created NotificationChannel
+	tested := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == notificationChannelTestPath:
+			tested = true
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = w.Write([]byte(`{"error":"slack returned 404"}`))
+		case r.Method == http.MethodPut:
+			_ = json.NewDecoder(r.Body).Decode(&created)
+			_, _ = w.Write([]byte(`{"notificationChannel":{"id":"channel_1"}}`))
+		default:
+			_, _ = w.Write(loadFixture(t, "notification_channel_list.json"))
+		}
+	}))
+	defer server.Close()
+
+	state, diags := applyResource(t, &Config{BackendURL: server.URL, Token: "test"}, "goliatdashboard_notification_channel", map[string]cty.Value{
+		"organization": cty.StringVal("new_provider_org"),
+		"name":         cty.StringVal("On-call"),
+		"type":         cty.StringVal("slack"),
+		"slack": cty.TupleVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"webhook_url_wo": cty.StringVal("https://hooks.slack.com/services/T0/B0/x"),
+			"channel":        cty.StringVal("#oncall"),
+		})}),
+		"send_test_on_create": cty.True,
+	})
+	assert.False(t, diags.HasError())
+	assert.True(t, tested)
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "https://hooks.slack.com/services/T0/B0/x", created.Slack.WebhookURL)
+	assert.Nil(t, created.Webhook)
+	assert.Equal(t, "new_provider_org/channel_1", state.GetAttr("id").AsString())
+}
+
+func TestResourceNotificationChannelCreate_SecretNotInState(t *testing.T) {
+	var created NotificationChannel
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			_ = json.NewDecoder(r.Body).Decode(&created)
+			_, _ = w.Write([]byte(`{"notificationChannel":{"id":"channel_2"}}`))
+			return
+		}
+		_, _ = w.Write(loadFixture(t, "notification_channel_list.json"))
+	}))
+	defer server.Close()
+
+	state, diags := applyResource(t, &Config{BackendURL: server.URL, Token: "test"}, "goliatdashboard_notification_channel", map[string]cty.Value{
+		"organization": cty.StringVal("new_provider_org"),
+		"name":         cty.StringVal("Incident bridge"),
+		"type":         cty.StringVal("webhook"),
+		"webhook": cty.TupleVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"url":               cty.StringVal("https://hooks.example.com/goliat"),
+			"secret_wo":         cty.StringVal("s3cret"),
+			"secret_wo_version": cty.NumberIntVal(1),
+		})}),
+	})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "s3cret", created.Webhook.Secret)
+
+	webhook := state.GetAttr("webhook").Index(cty.NumberIntVal(0))
+	assert.True(t, webhook.GetAttr("secret_wo").IsNull())
+	assert.Equal(t, cty.NumberIntVal(1), webhook.GetAttr("secret_wo_version"))
+	assert.Equal(t, "https://hooks.example.com/goliat", webhook.GetAttr("url").AsString())
+}
@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const organizationMembersPath = "/api/public/provider/organization-members"
+
+type OrganizationMember struct {
+	Organization string `json:"organization"`
+	UserID       string `json:"userId"`
+	Email        string `json:"email"`
+	Role         string `json:"role"`
+	RoleID       string `json:"roleId,omitempty"`
+}
+
+// OrganizationMemberListResponse is the body returned when listing the
+// members of an organization.
+type OrganizationMemberListResponse struct {
+	OrganizationMembers *[]OrganizationMember `json:"OrganizationMembers"`
+}
+
+// OrganizationMemberEnvelope is the body returned when adding or updating a
+// member.
+type OrganizationMemberEnvelope struct {
+	Member *OrganizationMember `json:"member"`
+}
+
+var organizationMemberRoles = []string{"owner", "admin", "member", "viewer"}
+
+// organizationManagerRoles are the roles allowed to manage the members of an
+// organization.
+var organizationManagerRoles = []string{"owner", "admin"}
+
+func resourceOrganizationMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOrganizationMemberCreate,
+		ReadContext:   resourceOrganizationMemberRead,
+		UpdateContext: resourceOrganizationMemberUpdate,
+		DeleteContext: resourceOrganizationMemberDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceOrganizationMemberImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"email", "user_id"},
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"role", "role_id"},
+				ValidateFunc: validation.StringInSlice(organizationMemberRoles, false),
+			},
+			"role_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func expandOrganizationMember(d *schema.ResourceData) (OrganizationMember, error) {
+	organization, ok := d.Get("organization").(string)
+	if !ok {
+		return OrganizationMember{}, fmt.Errorf("organization must be a string")
+	}
+	email, ok := d.Get("email").(string)
+	if !ok {
+		return OrganizationMember{}, fmt.Errorf("email must be a string")
+	}
+	userID, ok := d.Get("user_id").(string)
+	if !ok {
+		return OrganizationMember{}, fmt.Errorf("user_id must be a string")
+	}
+	role, ok := d.Get("role").(string)
+	if !ok {
+		return OrganizationMember{}, fmt.Errorf("role must be a string")
+	}
+	roleID, ok := d.Get("role_id").(string)
+	if !ok {
+		return OrganizationMember{}, fmt.Errorf("role_id must be a string")
+	}
+	// role is computed when a custom role is referenced, so the value left
+	// over from a previous built-in role must not be sent alongside it.
+	if roleID != "" {
+		role = ""
+	}
+	return OrganizationMember{
+		Organization: organization,
+		UserID:       userID,
+		Email:        email,
+		Role:         role,
+		RoleID:       roleID,
+	}, nil
+}
+
+func resourceOrganizationMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	member, err := expandOrganizationMember(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var envelope OrganizationMemberEnvelope
+	if diags := config.writeJSON(ctx, http.MethodPut, organizationMembersPath, "Unable to add organization member", member, &envelope); diags.HasError() {
+		return diags
+	}
+	if envelope.Member == nil || envelope.Member.UserID == "" {
+		return diag.Errorf("user ID not found in organization member response")
+	}
+
+	d.SetId(buildID(member.Organization, envelope.Member.UserID))
+	return resourceOrganizationMemberRead(ctx, d, meta)
+}
+
+func resourceOrganizationMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/user")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	org, user := parts[0], parts[1]
+
+	var result OrganizationMemberListResponse
+	gone, diags := config.readJSON(ctx, organizationListPath(organizationMembersPath, org), "Unable to read organization members", &result)
+	if diags.HasError() {
+		return diags
+	}
+	if gone {
+		log.Printf("[WARN] Organization %q not found, removing member %q from state", org, user)
+		d.SetId("")
+		return nil
+	}
+	if result.OrganizationMembers == nil {
+		return diag.Errorf("response does not contain OrganizationMembers")
+	}
+
+	member := findOrganizationMember(*result.OrganizationMembers, user)
+	if member == nil {
+		log.Printf("[WARN] Member %q not found in organization %q, removing from state", user, org)
+		d.SetId("")
+		return nil
+	}
+
+	// Imports may use the email address; normalize on the user ID.
+	d.SetId(buildID(org, member.UserID))
+	if err := d.Set("organization", org); err != nil {
+		return diag.Errorf("error setting organization: %s", err)
+	}
+	if err := d.Set("user_id", member.UserID); err != nil {
+		return diag.Errorf("error setting user_id: %s", err)
+	}
+	if err := d.Set("email", member.Email); err != nil {
+		return diag.Errorf("error setting email: %s", err)
+	}
+	if err := d.Set("role", member.Role); err != nil {
+		return diag.Errorf("error setting role: %s", err)
+	}
+	if err := d.Set("role_id", member.RoleID); err != nil {
+		return diag.Errorf("error setting role_id: %s", err)
+	}
+	return nil
+}
+
+func resourceOrganizationMemberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	member, err := expandOrganizationMember(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := config.writeJSON(ctx, http.MethodPut, organizationMembersPath, "Unable to update organization member", member, nil); diags.HasError() {
+		return diags
+	}
+	return resourceOrganizationMemberRead(ctx, d, meta)
+}
+
+func resourceOrganizationMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	member, err := expandOrganizationMember(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	payload := map[string]string{
+		"organization": member.Organization,
+		"userId":       member.UserID,
+	}
+	if diags := config.deleteJSON(ctx, organizationMembersPath, "Unable to remove organization member", payload); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceOrganizationMemberImport accepts "organization/user", where user is
+// either the user ID or the member's email address.
+func resourceOrganizationMemberImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseID(d.Id(), 2, "organization/user")
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("organization", parts[0]); err != nil {
+		return nil, err
+	}
+	if strings.Contains(parts[1], "@") {
+		if err := d.Set("email", parts[1]); err != nil {
+			return nil, err
+		}
+	} else if err := d.Set("user_id", parts[1]); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// findOrganizationMember returns the member whose user ID or email matches
+// user, or nil.
+func findOrganizationMember(members []OrganizationMember, user string) *OrganizationMember {
+	for i := range members {
+		if members[i].UserID == user || strings.EqualFold(members[i].Email, user) {
+			return &members[i]
+		}
+	}
+	return nil
+}
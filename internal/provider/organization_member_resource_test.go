@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccOrganizationMemberResource(t *testing.T) {
+	token := os.Getenv("EXAMPLE_TOKEN")
+	if token == "" {
+		t.Fatal("EXAMPLE_TOKEN is not set in environment variables")
+	}
+
+	config := func(role string) string {
+		return `
+provider "goliatdashboard" {
+  backend_url = "https://demo.goliat-dashboard.com"
+  token       = "` + token + `"
+}
+
+resource "goliatdashboard_organization" "test_org" {
+  name = "new_provider_org"
+  type = "providerOrganizations"
+}
+
+resource "goliatdashboard_organization_member" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  email        = "member@goliat-dashboard.com"
+  role         = "` + role + `"
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"goliatdashboard": func() (*schema.Provider, error) { //nolint:unparam
+				return Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("viewer"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_organization_member.test", "role", "viewer"),
+					resource.TestCheckResourceAttrSet("goliatdashboard_organization_member.test", "user_id"),
+				),
+			},
+			{
+				Config: config("admin"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_organization_member.test", "role", "admin"),
+				),
+			},
+			{
+				ResourceName:      "goliatdashboard_organization_member.test",
+				ImportState:       true,
+				ImportStateId:     "new_provider_org/member@goliat-dashboard.com",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceOrganizationMemberRead(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query().Get("organization")
+		_, _ = w.Write(loadFixture(t, "organization_member_list.json"))
+	}))
+	defer server.Close()
+
+	// Imported by email: Read resolves the canonical user ID.
+	d := schema.TestResourceDataRaw(t, resourceOrganizationMember().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org/Member@goliat-dashboard.com")
+
+	diags := resourceOrganizationMemberRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "new_provider_org", query)
+	assert.Equal(t, "new_provider_org/usr_2", d.Id())
+	assert.Equal(t, "usr_2", d.Get("user_id"))
+	assert.Equal(t, "admin", d.Get("role"))
+}
+
+func TestResourceOrganizationMemberRead_Removed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadFixture(t, "organization_member_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceOrganizationMember().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org/usr_9")
+
+	diags := resourceOrganizationMemberRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Empty(t, d.Id())
+}
+
+func TestResourceOrganizationMemberImport(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceOrganizationMember().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org")
+
+	_, err := resourceOrganizationMemberImport(context.Background(), d, nil)
+	assert.ErrorContains(t, err, "expected organization/user")
+}
+
+func TestExpandOrganizationMember_CustomRole(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceOrganizationMember().Schema, map[string]interface{}{
+		"organization": "new_provider_org",
+		"email":        "member@goliat-dashboard.com",
+		"role_id":      "role_1",
+	})
+	// Left over from a previous built-in role.
+	assert.NoError(t, d.Set("role", "admin"))
+
+	member, err := expandOrganizationMember(d)
+	assert.NoError(t, err)
+	assert.Equal(t, "role_1", member.RoleID)
+	assert.Empty(t, member.Role)
+}
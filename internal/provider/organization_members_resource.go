@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourceOrganizationMembers manages the complete member list of an
+// organization. Members that are not declared are removed.
+func resourceOrganizationMembers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOrganizationMembersApply,
+		ReadContext:   resourceOrganizationMembersRead,
+		UpdateContext: resourceOrganizationMembersApply,
+		DeleteContext: resourceOrganizationMembersDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"member": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email": {
+							Type:     schema.TypeString,
+							Required: true,
+							StateFunc: func(v interface{}) string {
+								s, _ := v.(string)
+								return strings.ToLower(s)
+							},
+						},
+						"role": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(organizationMemberRoles, false),
+						},
+						"role_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// expandOrganizationMembers returns the declared members keyed by lower-cased
+// email address. Each member needs exactly one of role and role_id.
+func expandOrganizationMembers(org string, set *schema.Set) (map[string]OrganizationMember, error) {
+	result := make(map[string]OrganizationMember, set.Len())
+	for _, raw := range set.List() {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		email, _ := m["email"].(string)
+		role, _ := m["role"].(string)
+		roleID, _ := m["role_id"].(string)
+		if (role == "") == (roleID == "") {
+			return nil, fmt.Errorf("member %q must set exactly one of role and role_id", email)
+		}
+		email = strings.ToLower(email)
+		result[email] = OrganizationMember{
+			Organization: org,
+			Email:        email,
+			Role:         role,
+			RoleID:       roleID,
+		}
+	}
+	return result, nil
+}
+
+func flattenOrganizationMembers(members []OrganizationMember) []interface{} {
+	result := make([]interface{}, 0, len(members))
+	for _, m := range members {
+		result = append(result, map[string]interface{}{
+			"email":   strings.ToLower(m.Email),
+			"role":    m.Role,
+			"role_id": m.RoleID,
+		})
+	}
+	return result
+}
+
+func resourceOrganizationMembersApply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	org, ok := d.Get("organization").(string)
+	if !ok {
+		return diag.Errorf("organization must be a string")
+	}
+	set, ok := d.Get("member").(*schema.Set)
+	if !ok {
+		return diag.Errorf("member must be a set")
+	}
+	desired, err := expandOrganizationMembers(org, set)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	owner, diags := config.tokenOwner(ctx)
+	if diags.HasError() {
+		return diags
+	}
+	self, ok := desired[strings.ToLower(owner.Email)]
+	if !ok {
+		return diag.Errorf("refusing to apply: the member list for organization %q does not include %q, the owner of the provider token, who would lose access", org, owner.Email)
+	}
+	if !slices.Contains(organizationManagerRoles, self.Role) {
+		return diag.Errorf("refusing to apply: the member list for organization %q gives %q, the owner of the provider token, the %q role, which cannot manage members; use one of %s", org, owner.Email, self.Role, strings.Join(organizationManagerRoles, ", "))
+	}
+
+	var result OrganizationMemberListResponse
+	gone, diags := config.readJSON(ctx, organizationListPath(organizationMembersPath, org), "Unable to read organization members", &result)
+	if diags.HasError() {
+		return diags
+	}
+	if gone {
+		return diag.Errorf("organization %q not found", org)
+	}
+	if result.OrganizationMembers == nil {
+		return diag.Errorf("response does not contain OrganizationMembers")
+	}
+
+	current := make(map[string]OrganizationMember, len(*result.OrganizationMembers))
+	for _, m := range *result.OrganizationMembers {
+		current[strings.ToLower(m.Email)] = m
+	}
+
+	for email, m := range desired {
+		if existing, ok := current[email]; ok && existing.Role == m.Role && existing.RoleID == m.RoleID {
+			continue
+		}
+		if diags := config.writeJSON(ctx, http.MethodPut, organizationMembersPath, fmt.Sprintf("Unable to save organization member %q", email), m, nil); diags.HasError() {
+			return diags
+		}
+	}
+
+	for email, m := range current {
+		if _, ok := desired[email]; ok {
+			continue
+		}
+		payload := map[string]string{
+			"organization": org,
+			"userId":       m.UserID,
+		}
+		if diags := config.deleteJSON(ctx, organizationMembersPath, fmt.Sprintf("Unable to remove organization member %q", email), payload); diags.HasError() {
+			return diags
+		}
+	}
+
+	d.SetId(org)
+	return resourceOrganizationMembersRead(ctx, d, meta)
+}
+
+func resourceOrganizationMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	org := d.Id()
+	var result OrganizationMemberListResponse
+	gone, diags := config.readJSON(ctx, organizationListPath(organizationMembersPath, org), "Unable to read organization members", &result)
+	if diags.HasError() {
+		return diags
+	}
+	if gone {
+		log.Printf("[WARN] Organization %q not found, removing member list from state", org)
+		d.SetId("")
+		return nil
+	}
+	if result.OrganizationMembers == nil {
+		return diag.Errorf("response does not contain OrganizationMembers")
+	}
+
+	if err := d.Set("organization", org); err != nil {
+		return diag.Errorf("error setting organization: %s", err)
+	}
+	if err := d.Set("member", flattenOrganizationMembers(*result.OrganizationMembers)); err != nil {
+		return diag.Errorf("error setting member: %s", err)
+	}
+	return nil
+}
+
+// resourceOrganizationMembersDelete removes every member of the organization
+// except the owner of the provider token, so destroying the resource never
+// locks the provider out of the organization.
+func resourceOrganizationMembersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	owner, diags := config.tokenOwner(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	var result OrganizationMemberListResponse
+	gone, diags := config.readJSON(ctx, organizationListPath(organizationMembersPath, d.Id()), "Unable to read organization members", &result)
+	if diags.HasError() {
+		return diags
+	}
+	if gone {
+		d.SetId("")
+		return nil
+	}
+	if result.OrganizationMembers == nil {
+		return diag.Errorf("response does not contain OrganizationMembers")
+	}
+
+	for _, m := range *result.OrganizationMembers {
+		if m.UserID == owner.UserID || strings.EqualFold(m.Email, owner.Email) {
+			continue
+		}
+		payload := map[string]string{
+			"organization": d.Id(),
+			"userId":       m.UserID,
+		}
+		if diags := config.deleteJSON(ctx, organizationMembersPath, fmt.Sprintf("Unable to remove organization member %q", m.Email), payload); diags.HasError() {
+			return diags
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
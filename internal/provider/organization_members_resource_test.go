@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// newOrganizationMembersServer serves the member list fixture and records the
+// writes made against it.
+func newOrganizationMembersServer(t *testing.T, puts, deletes *[]OrganizationMember) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/public/provider/me":
+			_, _ = w.Write([]byte(`{"user":{"userId":"usr_1","email":"owner@goliat-dashboard.com"}}`))
+		case r.Method == http.MethodGet:
+			_, _ = w.Write(loadFixture(t, "organization_member_list.json"))
+		case r.Method == http.MethodPut:
+			var m OrganizationMember
+			_ = json.NewDecoder(r.Body).Decode(&m)
+			*puts = append(*puts, m)
+			_, _ = w.Write([]byte(`{"member":{}}`))
+		case r.Method == http.MethodDelete:
+			var m OrganizationMember
+			_ = json.NewDecoder(r.Body).Decode(&m)
+			*deletes = append(*deletes, m)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+}
+
+func TestResourceOrganizationMembersApply(t *testing.T) {
+	var puts, deletes []OrganizationMember
+	server := newOrganizationMembersServer(t, &puts, &deletes)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceOrganizationMembers().Schema, map[string]interface{}{
+		"organization": "new_provider_org",
+		"member": []interface{}{
+			map[string]interface{}{"email": "Owner@goliat-dashboard.com", "role": "owner"},
+			map[string]interface{}{"email": "new@goliat-dashboard.com", "role": "viewer"},
+		},
+	})
+
+	diags := resourceOrganizationMembersApply(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "new_provider_org", d.Id())
+
+	// The owner is unchanged, the new member is added and the undeclared
+	// admin is removed.
+	if assert.Len(t, puts, 1) {
+		assert.Equal(t, "new@goliat-dashboard.com", puts[0].Email)
+	}
+	if assert.Len(t, deletes, 1) {
+		assert.Equal(t, "usr_2", deletes[0].UserID)
+	}
+}
+
+func TestResourceOrganizationMembersApply_RefusesOwnerRemoval(t *testing.T) {
+	var puts, deletes []OrganizationMember
+	server := newOrganizationMembersServer(t, &puts, &deletes)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceOrganizationMembers().Schema, map[string]interface{}{
+		"organization": "new_provider_org",
+		"member": []interface{}{
+			map[string]interface{}{"email": "member@goliat-dashboard.com", "role": "admin"},
+		},
+	})
+
+	diags := resourceOrganizationMembersApply(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "owner@goliat-dashboard.com")
+	assert.Empty(t, puts)
+	assert.Empty(t, deletes)
+}
+
+func TestResourceOrganizationMembersApply_RefusesOwnerDemotion(t *testing.T) {
+	var puts, deletes []OrganizationMember
+	server := newOrganizationMembersServer(t, &puts, &deletes)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceOrganizationMembers().Schema, map[string]interface{}{
+		"organization": "new_provider_org",
+		"member": []interface{}{
+			map[string]interface{}{"email": "owner@goliat-dashboard.com", "role": "viewer"},
+			map[string]interface{}{"email": "member@goliat-dashboard.com", "role": "admin"},
+		},
+	})
+
+	diags := resourceOrganizationMembersApply(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, `"viewer" role`)
+	assert.Empty(t, puts)
+	assert.Empty(t, deletes)
+}
+
+func TestResourceOrganizationMembersDelete_KeepsOwner(t *testing.T) {
+	var puts, deletes []OrganizationMember
+	server := newOrganizationMembersServer(t, &puts, &deletes)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceOrganizationMembers().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org")
+
+	diags := resourceOrganizationMembersDelete(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	if assert.Len(t, deletes, 1) {
+		assert.Equal(t, "usr_2", deletes[0].UserID)
+	}
+}
+
+func TestResourceOrganizationMembersRead_CustomRole(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"OrganizationMembers":[{"organization":"new_provider_org","userId":"usr_3","email":"auditor@goliat-dashboard.com","role":"","roleId":"role_auditor"}]}`))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceOrganizationMembers().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org")
+
+	diags := resourceOrganizationMembersRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+
+	// A member declared with role_id hashes to the same element as the one
+	// read back, so custom-role members do not diff on every plan.
+	declared := schema.NewSet(d.Get("member").(*schema.Set).F, []interface{}{
+		map[string]interface{}{"email": "auditor@goliat-dashboard.com", "role": "", "role_id": "role_auditor"},
+	})
+	assert.True(t, declared.Equal(d.Get("member")))
+}
+
+func TestResourceOrganizationMembersApply_RequiresOneRole(t *testing.T) {
+	var puts, deletes []OrganizationMember
+	server := newOrganizationMembersServer(t, &puts, &deletes)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceOrganizationMembers().Schema, map[string]interface{}{
+		"organization": "new_provider_org",
+		"member": []interface{}{
+			map[string]interface{}{"email": "owner@goliat-dashboard.com", "role": "owner", "role_id": "role_auditor"},
+		},
+	})
+
+	diags := resourceOrganizationMembersApply(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "exactly one of role and role_id")
+	assert.Empty(t, puts)
+	assert.Empty(t, deletes)
+}
+
+func TestResourceOrganizationMembersDelete_MissingList(t *testing.T) {
+	var deleted bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/public/provider/me":
+			_, _ = w.Write([]byte(`{"user":{"userId":"usr_1","email":"owner@goliat-dashboard.com"}}`))
+		case r.Method == http.MethodDelete:
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceOrganizationMembers().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org")
+
+	diags := resourceOrganizationMembersDelete(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "response does not contain OrganizationMembers", diags[0].Summary)
+	assert.False(t, deleted)
+	assert.Equal(t, "new_provider_org", d.Id())
+}
@@ -5,12 +5,15 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -22,9 +25,9 @@ type Organization struct {
 
 func resourceOrganization() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceOrganizationCreate,
-		Read:   resourceOrganizationRead,
-		Delete: resourceOrganizationDelete,
+		Create:      resourceOrganizationCreate,
+		ReadContext: resourceOrganizationRead,
+		Delete:      resourceOrganizationDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceOrganizationImport,
 		},
@@ -100,62 +103,55 @@ func resourceOrganizationCreate(d *schema.ResourceData, meta interface{}) error
 	defer resp.Body.Close()
 
 	responseBody, _ := io.ReadAll(resp.Body)
-	fmt.Printf("Response from backend: %s\n", string(responseBody))
 
 	if resp.StatusCode != http.StatusOK {
 		return fmt.Errorf("creation failed, status code: %d, response: %s", resp.StatusCode, string(responseBody))
 	}
 
 	d.SetId(payload.ID)
-	fmt.Printf("Resource ID set in Terraform: %s\n", d.Id())
 	return nil
 }
 
-func resourceOrganizationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceOrganizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config, ok := meta.(*Config)
 	if !ok {
-		return fmt.Errorf("error converting meta to *Config")
+		return diag.Errorf("error converting meta to *Config")
 	}
 
-	url := fmt.Sprintf("%s/api/public/provider/organizations", config.BackendURL)
-
-	req, err := http.NewRequest("GET", url, nil)
+	statusCode, body, err := config.doRequest(ctx, http.MethodGet, "/api/public/provider/organizations", nil)
 	if err != nil {
-		return fmt.Errorf("error creating GET request: %s", err)
+		return diag.FromErr(err)
 	}
-	req.Header.Set("Authorization", "Bearer "+config.Token)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return fmt.Errorf("error sending GET request to backend: %s", err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("error reading response: %s", err)
+	switch classifyResponse(statusCode) {
+	case responseOK:
+	case responseNotFound:
+		log.Printf("[WARN] Organization %q not found (status %d), removing from state", d.Id(), statusCode)
+		d.SetId("")
+		return nil
+	default:
+		return diag.Diagnostics{responseDiagnostic("Unable to read organization", statusCode, body)}
 	}
 
+	// A pointer distinguishes an empty list, which confirms the organization
+	// is gone, from a body that lacks the list entirely.
 	var result struct {
-		ProviderOrganizations []Organization `json:"ProviderOrganizations"`
+		ProviderOrganizations *[]Organization `json:"ProviderOrganizations"`
 	}
 	if err := json.Unmarshal(body, &result); err != nil {
-		return fmt.Errorf("error unmarshalling JSON response: %s", err)
+		return diag.Diagnostics{decodeDiagnostic("Unable to decode organization list", err, body)}
 	}
-
-	found := false
-	for _, org := range result.ProviderOrganizations {
+	if result.ProviderOrganizations == nil {
+		return diag.Diagnostics{decodeDiagnostic("Unable to decode organization list", fmt.Errorf("response does not contain ProviderOrganizations"), body)}
+	}
+	for _, org := range *result.ProviderOrganizations {
 		if org.ID == d.Id() {
-			found = true
-			break
+			return nil
 		}
 	}
 
-	if !found {
-		d.SetId("")
-	}
-
+	log.Printf("[WARN] Organization %q not found in organization list, removing from state", d.Id())
+	d.SetId("")
 	return nil
 }
 
@@ -195,8 +191,10 @@ func resourceOrganizationDelete(d *schema.ResourceData, meta interface{}) error
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("deletion failed, status code: %d", resp.StatusCode)
+	responseBody, _ := io.ReadAll(resp.Body)
+
+	if !isDeleteSuccess(resp.StatusCode) {
+		return fmt.Errorf("deletion failed, status code: %d, response: %s", resp.StatusCode, string(responseBody))
 	}
 
 	d.SetId("")
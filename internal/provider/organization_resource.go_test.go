@@ -4,10 +4,12 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
@@ -15,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccOrganizationResource(t *testing.T) {
@@ -147,3 +150,88 @@ func testAccCheckOrganizationDestroyed(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestResourceOrganizationDelete_Status(t *testing.T) {
+	cases := map[int]bool{
+		http.StatusOK:                  false,
+		http.StatusNoContent:           false,
+		http.StatusNotFound:            false,
+		http.StatusGone:                false,
+		http.StatusInternalServerError: true,
+	}
+
+	for status, wantErr := range cases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+			fmt.Fprint(w, "backend says no")
+		}))
+
+		d := schema.TestResourceDataRaw(t, resourceOrganization().Schema, map[string]interface{}{
+			"name": "new_provider_org",
+			"type": "providerOrganizations",
+		})
+		d.SetId("new_provider_org")
+
+		err := resourceOrganizationDelete(d, &Config{BackendURL: server.URL, Token: "test"})
+		server.Close()
+
+		if wantErr {
+			assert.ErrorContains(t, err, "backend says no", "status %d", status)
+			assert.Equal(t, "new_provider_org", d.Id(), "status %d", status)
+		} else {
+			assert.NoError(t, err, "status %d", status)
+			assert.Empty(t, d.Id(), "status %d", status)
+		}
+	}
+}
+
+func TestResourceOrganizationRead_Status(t *testing.T) {
+	cases := []struct {
+		name       string
+		status     int
+		body       string
+		wantErr    string
+		wantRemove bool
+	}{
+		{name: "found", status: http.StatusOK, body: `{"ProviderOrganizations":[{"id":"new_provider_org","name":"new_provider_org"}]}`},
+		{name: "absent from list", status: http.StatusOK, body: `{"ProviderOrganizations":[{"id":"other_org","name":"other_org"}]}`, wantRemove: true},
+		{name: "empty list", status: http.StatusOK, body: `{"ProviderOrganizations":[]}`, wantRemove: true},
+		{name: "gone", status: http.StatusGone, body: `gone`, wantRemove: true},
+		{name: "not found", status: http.StatusNotFound, body: `not found`, wantRemove: true},
+		{name: "missing list", status: http.StatusOK, body: `{}`, wantErr: "does not contain ProviderOrganizations"},
+		{name: "unauthorized", status: http.StatusUnauthorized, body: `<html>login required</html>`, wantErr: "<html>login required</html>"},
+		{name: "server error", status: http.StatusInternalServerError, body: `upstream timeout`, wantErr: "upstream timeout"},
+		{name: "invalid json", status: http.StatusOK, body: `<html>ok</html>`, wantErr: "<html>ok</html>"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				fmt.Fprint(w, tc.body)
+			}))
+			defer server.Close()
+
+			d := schema.TestResourceDataRaw(t, resourceOrganization().Schema, map[string]interface{}{
+				"name": "new_provider_org",
+				"type": "providerOrganizations",
+			})
+			d.SetId("new_provider_org")
+
+			diags := resourceOrganizationRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+
+			if tc.wantErr != "" {
+				assert.True(t, diags.HasError())
+				assert.Contains(t, diags[0].Detail, tc.wantErr)
+				assert.Equal(t, "new_provider_org", d.Id())
+				return
+			}
+			assert.False(t, diags.HasError())
+			if tc.wantRemove {
+				assert.Empty(t, d.Id())
+			} else {
+				assert.Equal(t, "new_provider_org", d.Id())
+			}
+		})
+	}
+}
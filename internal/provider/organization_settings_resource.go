@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const organizationSettingsPath = "/api/public/provider/organization-settings"
+
+// OrganizationSettings is the organization-wide configuration. Every
+// organization has exactly one set of settings; fields left out of a PUT
+// keep their current value.
+type OrganizationSettings struct {
+	Organization        string   `json:"organization"`
+	DataRetentionDays   int      `json:"dataRetentionDays,omitempty"`
+	DefaultMemberRole   string   `json:"defaultMemberRole,omitempty"`
+	AllowedEmailDomains []string `json:"allowedEmailDomains"`
+	SSOEnforced         bool     `json:"ssoEnforced"`
+}
+
+// OrganizationSettingsEnvelope is the body returned when reading the settings
+// of an organization.
+type OrganizationSettingsEnvelope struct {
+	Settings *OrganizationSettings `json:"settings"`
+}
+
+func resourceOrganizationSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOrganizationSettingsPut,
+		ReadContext:   resourceOrganizationSettingsRead,
+		UpdateContext: resourceOrganizationSettingsPut,
+		DeleteContext: resourceOrganizationSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"data_retention_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(7, 3650),
+			},
+			"default_member_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"admin", "member", "viewer"}, false),
+			},
+			"allowed_email_domains": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(domainNamePattern, "must be a lowercase domain name such as \"example.com\""),
+				},
+			},
+			"sso_enforced": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func expandOrganizationSettings(d *schema.ResourceData) (OrganizationSettings, error) {
+	organization, ok := d.Get("organization").(string)
+	if !ok {
+		return OrganizationSettings{}, fmt.Errorf("organization must be a string")
+	}
+	retention, ok := d.Get("data_retention_days").(int)
+	if !ok {
+		return OrganizationSettings{}, fmt.Errorf("data_retention_days must be an integer")
+	}
+	role, ok := d.Get("default_member_role").(string)
+	if !ok {
+		return OrganizationSettings{}, fmt.Errorf("default_member_role must be a string")
+	}
+	domains, ok := d.Get("allowed_email_domains").(*schema.Set)
+	if !ok {
+		return OrganizationSettings{}, fmt.Errorf("allowed_email_domains must be a set")
+	}
+	ssoEnforced, ok := d.Get("sso_enforced").(bool)
+	if !ok {
+		return OrganizationSettings{}, fmt.Errorf("sso_enforced must be a bool")
+	}
+	return OrganizationSettings{
+		Organization:        organization,
+		DataRetentionDays:   retention,
+		DefaultMemberRole:   role,
+		AllowedEmailDomains: expandStringSet(domains),
+		SSOEnforced:         ssoEnforced,
+	}, nil
+}
+
+// resourceOrganizationSettingsPut creates or updates the settings. Settings
+// always exist, so creating the resource adopts them: data_retention_days
+// and default_member_role keep the backend value until they are configured.
+func resourceOrganizationSettingsPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	settings, err := expandOrganizationSettings(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := config.writeJSON(ctx, http.MethodPut, organizationSettingsPath, "Unable to save organization settings", settings, nil); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(settings.Organization)
+	return resourceOrganizationSettingsRead(ctx, d, meta)
+}
+
+func resourceOrganizationSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	org := d.Id()
+
+	var result OrganizationSettingsEnvelope
+	gone, diags := config.readJSON(ctx, organizationListPath(organizationSettingsPath, org), "Unable to read organization settings", &result)
+	if diags.HasError() {
+		return diags
+	}
+	if gone {
+		log.Printf("[WARN] Organization %q not found, removing its settings from state", org)
+		d.SetId("")
+		return nil
+	}
+	if result.Settings == nil {
+		return diag.Errorf("response does not contain settings")
+	}
+
+	settings := result.Settings
+	if err := d.Set("organization", org); err != nil {
+		return diag.Errorf("error setting organization: %s", err)
+	}
+	if err := d.Set("data_retention_days", settings.DataRetentionDays); err != nil {
+		return diag.Errorf("error setting data_retention_days: %s", err)
+	}
+	if err := d.Set("default_member_role", settings.DefaultMemberRole); err != nil {
+		return diag.Errorf("error setting default_member_role: %s", err)
+	}
+	if err := d.Set("allowed_email_domains", settings.AllowedEmailDomains); err != nil {
+		return diag.Errorf("error setting allowed_email_domains: %s", err)
+	}
+	if err := d.Set("sso_enforced", settings.SSOEnforced); err != nil {
+		return diag.Errorf("error setting sso_enforced: %s", err)
+	}
+	return nil
+}
+
+// resourceOrganizationSettingsDelete restores the backend defaults. The
+// settings themselves cannot be removed; a DELETE resets every field.
+func resourceOrganizationSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	payload := map[string]string{
+		"organization": d.Id(),
+	}
+	if diags := config.deleteJSON(ctx, organizationSettingsPath, "Unable to reset organization settings", payload); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccOrganizationSettingsResource(t *testing.T) {
+	token := os.Getenv("EXAMPLE_TOKEN")
+	if token == "" {
+		t.Fatal("EXAMPLE_TOKEN is not set in environment variables")
+	}
+
+	config := func(retention string) string {
+		return `
+provider "goliatdashboard" {
+  backend_url = "https://demo.goliat-dashboard.com"
+  token       = "` + token + `"
+}
+
+resource "goliatdashboard_organization" "test_org" {
+  name = "new_provider_org"
+  type = "providerOrganizations"
+}
+
+resource "goliatdashboard_organization_settings" "test" {
+  organization          = goliatdashboard_organization.test_org.name
+  data_retention_days   = ` + retention + `
+  allowed_email_domains = ["goliat-dashboard.com"]
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"goliatdashboard": func() (*schema.Provider, error) { //nolint:unparam
+				return Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("90"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_organization_settings.test", "id", "new_provider_org"),
+					resource.TestCheckResourceAttr("goliatdashboard_organization_settings.test", "data_retention_days", "90"),
+					resource.TestCheckResourceAttrSet("goliatdashboard_organization_settings.test", "default_member_role"),
+				),
+			},
+			{
+				Config: config("30"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_organization_settings.test", "data_retention_days", "30"),
+				),
+			},
+			{
+				ResourceName:      "goliatdashboard_organization_settings.test",
+				ImportState:       true,
+				ImportStateId:     "new_provider_org",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceOrganizationSettingsRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "new_provider_org", r.URL.Query().Get("organization"))
+		_, _ = w.Write(loadFixture(t, "organization_settings.json"))
+	}))
+	defer server.Close()
+
+	// Import sets only the ID.
+	d := schema.TestResourceDataRaw(t, resourceOrganizationSettings().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org")
+
+	diags := resourceOrganizationSettingsRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "new_provider_org", d.Get("organization"))
+	assert.Equal(t, 90, d.Get("data_retention_days"))
+	assert.Equal(t, "viewer", d.Get("default_member_role"))
+	assert.ElementsMatch(t, []interface{}{"goliat-dashboard.com", "example.com"}, d.Get("allowed_email_domains").(*schema.Set).List()) //nolint:forcetypeassert
+	assert.Equal(t, true, d.Get("sso_enforced"))
+}
+
+func TestResourceOrganizationSettingsRead_Removed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceOrganizationSettings().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org")
+
+	diags := resourceOrganizationSettingsRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Empty(t, d.Id())
+}
+
+func TestResourceOrganizationSettingsPut_OmitsUnsetFields(t *testing.T) {
+	var sent map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			_ = json.NewDecoder(r.Body).Decode(&sent)
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		_, _ = w.Write(loadFixture(t, "organization_settings.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceOrganizationSettings().Schema, map[string]interface{}{
+		"organization": "new_provider_org",
+		"sso_enforced": true,
+	})
+
+	diags := resourceOrganizationSettingsPut(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "new_provider_org", d.Id())
+	assert.NotContains(t, sent, "dataRetentionDays")
+	assert.NotContains(t, sent, "defaultMemberRole")
+	assert.Equal(t, []interface{}{}, sent["allowedEmailDomains"])
+	assert.Equal(t, true, sent["ssoEnforced"])
+	// The backend values are adopted.
+	assert.Equal(t, 90, d.Get("data_retention_days"))
+}
+
+func TestResourceOrganizationSettingsDelete_ResetsDefaults(t *testing.T) {
+	var method string
+	var sent map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		assert.Equal(t, organizationSettingsPath, r.URL.Path)
+		_ = json.NewDecoder(r.Body).Decode(&sent)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceOrganizationSettings().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org")
+
+	diags := resourceOrganizationSettingsDelete(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, http.MethodDelete, method)
+	assert.Equal(t, map[string]string{"organization": "new_provider_org"}, sent)
+	assert.Empty(t, d.Id())
+}
@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const projectAccessPath = "/api/public/provider/project-access"
+
+// ProjectAccess grants a team a permission level on a project.
+type ProjectAccess struct {
+	Organization string `json:"organization"`
+	ProjectID    string `json:"projectId"`
+	TeamID       string `json:"teamId"`
+	Permission   string `json:"permission"`
+}
+
+// ProjectAccessListResponse is the body returned when listing the project
+// access grants of an organization.
+type ProjectAccessListResponse struct {
+	ProjectAccess *[]ProjectAccess `json:"ProjectAccess"`
+}
+
+func resourceProjectAccess() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProjectAccessPut,
+		ReadContext:   resourceProjectAccessRead,
+		UpdateContext: resourceProjectAccessPut,
+		DeleteContext: resourceProjectAccessDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"team_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"permission": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"read", "write", "admin"}, false),
+			},
+		},
+	}
+}
+
+func expandProjectAccess(d *schema.ResourceData) (ProjectAccess, error) {
+	organization, ok := d.Get("organization").(string)
+	if !ok {
+		return ProjectAccess{}, fmt.Errorf("organization must be a string")
+	}
+	projectID, ok := d.Get("project_id").(string)
+	if !ok {
+		return ProjectAccess{}, fmt.Errorf("project_id must be a string")
+	}
+	teamID, ok := d.Get("team_id").(string)
+	if !ok {
+		return ProjectAccess{}, fmt.Errorf("team_id must be a string")
+	}
+	permission, ok := d.Get("permission").(string)
+	if !ok {
+		return ProjectAccess{}, fmt.Errorf("permission must be a string")
+	}
+	return ProjectAccess{
+		Organization: organization,
+		ProjectID:    projectID,
+		TeamID:       teamID,
+		Permission:   permission,
+	}, nil
+}
+
+// resourceProjectAccessPut creates or updates the grant; the backend upserts
+// on organization, project and team.
+func resourceProjectAccessPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	access, err := expandProjectAccess(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := config.writeJSON(ctx, http.MethodPut, projectAccessPath, "Unable to save project access", access, nil); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(buildID(access.Organization, access.ProjectID, access.TeamID))
+	return resourceProjectAccessRead(ctx, d, meta)
+}
+
+func resourceProjectAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 3, "organization/project_id/team_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	org, projectID, teamID := parts[0], parts[1], parts[2]
+
+	var result ProjectAccessListResponse
+	gone, diags := config.readJSON(ctx, organizationListPath(projectAccessPath, org), "Unable to read project access", &result)
+	if diags.HasError() {
+		return diags
+	}
+	if gone {
+		log.Printf("[WARN] Organization %q not found, removing project access %q from state", org, d.Id())
+		d.SetId("")
+		return nil
+	}
+	if result.ProjectAccess == nil {
+		return diag.Errorf("response does not contain ProjectAccess")
+	}
+
+	for _, a := range *result.ProjectAccess {
+		if a.ProjectID != projectID || a.TeamID != teamID {
+			continue
+		}
+		if err := d.Set("organization", org); err != nil {
+			return diag.Errorf("error setting organization: %s", err)
+		}
+		if err := d.Set("project_id", a.ProjectID); err != nil {
+			return diag.Errorf("error setting project_id: %s", err)
+		}
+		if err := d.Set("team_id", a.TeamID); err != nil {
+			return diag.Errorf("error setting team_id: %s", err)
+		}
+		if err := d.Set("permission", a.Permission); err != nil {
+			return diag.Errorf("error setting permission: %s", err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] Project access %q not found, removing from state", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceProjectAccessDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	access, err := expandProjectAccess(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := config.deleteJSON(ctx, projectAccessPath, "Unable to delete project access", access); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceProjectAccessRead_Drift(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadFixture(t, "project_access_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceProjectAccess().Schema, map[string]interface{}{
+		"organization": "new_provider_org",
+		"project_id":   "6745b9c1e4b0a1d2c3f4a5b6",
+		"team_id":      "team_1",
+		"permission":   "read",
+	})
+	d.SetId("new_provider_org/6745b9c1e4b0a1d2c3f4a5b6/team_1")
+
+	diags := resourceProjectAccessRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "admin", d.Get("permission"))
+}
+
+func TestResourceProjectAccessRead_Removed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceProjectAccess().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org/6745b9c1e4b0a1d2c3f4a5b6/team_1")
+
+	diags := resourceProjectAccessRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Empty(t, d.Id())
+}
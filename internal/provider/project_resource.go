@@ -4,27 +4,74 @@
 package provider
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"io"
+	"log"
 	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 type Project struct {
-	ID           string `json:"id"`
-	Organization string `json:"organization"`
-	Name         string `json:"name"`
-	Description  string `json:"description"`
+	ID           string            `json:"id"`
+	Organization string            `json:"organization"`
+	Name         string            `json:"name"`
+	Description  string            `json:"description"`
+	Tags         []string          `json:"tags"`
+	Labels       map[string]string `json:"labels"`
+	Archived     bool              `json:"archived"`
+	Visibility   string            `json:"visibility"`
+}
+
+const (
+	projectVisibilityPrivate      = "private"
+	projectVisibilityOrganization = "organization"
+	projectVisibilityPublic       = "public"
+)
+
+// ProjectListResponse is the body returned when listing projects. Projects is
+// a pointer so that a body without the list is not mistaken for an empty one.
+type ProjectListResponse struct {
+	Projects *[]Project `json:"Projects"`
+}
+
+// ProjectEnvelope is the body returned when creating or updating a project.
+type ProjectEnvelope struct {
+	Project *Project `json:"project"`
+}
+
+// UnmarshalJSON decodes a project, accepting null or numeric values for the
+// scalar fields since the backend does not always emit them as strings.
+func (p *Project) UnmarshalJSON(data []byte) error {
+	type projectJSON Project
+	aux := struct {
+		ID           flexString `json:"id"`
+		Organization flexString `json:"organization"`
+		Name         flexString `json:"name"`
+		Description  flexString `json:"description"`
+		*projectJSON
+	}{projectJSON: (*projectJSON)(p)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	p.ID = string(aux.ID)
+	p.Organization = string(aux.Organization)
+	p.Name = string(aux.Name)
+	p.Description = string(aux.Description)
+	return nil
 }
 
 func resourceProject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceProjectCreate,
-		Read:   resourceProjectRead,
-		Update: resourceProjectUpdate,
-		Delete: resourceProjectDelete,
+		CreateContext: resourceProjectCreate,
+		ReadContext:   resourceProjectRead,
+		UpdateContext: resourceProjectUpdate,
+		DeleteContext: resourceProjectDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceProjectImport,
 		},
@@ -41,158 +88,240 @@ func resourceProject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"all_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"archived": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"visibility": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  projectVisibilityPrivate,
+				ValidateFunc: validation.StringInSlice([]string{
+					projectVisibilityPrivate,
+					projectVisibilityOrganization,
+					projectVisibilityPublic,
+				}, false),
+			},
+			"delete_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 		},
+		CustomizeDiff: resourceProjectCustomizeDiff,
 	}
 }
 
-func resourceProjectCreate(d *schema.ResourceData, meta interface{}) error {
+// resourceProjectCustomizeDiff plans all_labels as the provider default_labels
+// merged with the project labels.
+func resourceProjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	config, ok := meta.(*Config)
 	if !ok {
 		return fmt.Errorf("error converting meta to *Config")
 	}
 
+	if !d.NewValueKnown("labels") {
+		return d.SetNewComputed("all_labels")
+	}
+
+	labels, ok := d.Get("labels").(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("labels must be a map")
+	}
+	return d.SetNew("all_labels", mergeLabels(config.DefaultLabels, expandStringMap(labels)))
+}
+
+// expandProject builds the project payload from the resource configuration.
+func expandProject(d *schema.ResourceData, config *Config) (Project, error) {
 	organization, ok := d.Get("organization").(string)
 	if !ok {
-		return fmt.Errorf("organization must be a string")
+		return Project{}, fmt.Errorf("organization must be a string")
 	}
 	name, ok := d.Get("name").(string)
 	if !ok {
-		return fmt.Errorf("name must be a string")
+		return Project{}, fmt.Errorf("name must be a string")
 	}
 	description, ok := d.Get("description").(string)
 	if !ok {
-		return fmt.Errorf("description must be a string")
+		return Project{}, fmt.Errorf("description must be a string")
+	}
+	tags, ok := d.Get("tags").(*schema.Set)
+	if !ok {
+		return Project{}, fmt.Errorf("tags must be a set")
+	}
+	labels, ok := d.Get("labels").(map[string]interface{})
+	if !ok {
+		return Project{}, fmt.Errorf("labels must be a map")
+	}
+	archived, ok := d.Get("archived").(bool)
+	if !ok {
+		return Project{}, fmt.Errorf("archived must be a bool")
+	}
+	visibility, ok := d.Get("visibility").(string)
+	if !ok {
+		return Project{}, fmt.Errorf("visibility must be a string")
 	}
 
-	project := Project{
+	return Project{
+		ID:           d.Id(),
 		Organization: organization,
 		Name:         name,
 		Description:  description,
-	}
+		Tags:         expandStringSet(tags),
+		Labels:       mergeLabels(config.DefaultLabels, expandStringMap(labels)),
+		Archived:     archived,
+		Visibility:   visibility,
+	}, nil
+}
 
-	body, err := json.Marshal(project)
-	if err != nil {
-		return fmt.Errorf("error marshalling project to JSON: %s", err)
+func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
 	}
 
-	url := fmt.Sprintf("%s/api/public/provider/projects", config.BackendURL)
-	req, err := http.NewRequest("PUT", url, bytes.NewBuffer(body))
+	project, err := expandProject(d, config)
 	if err != nil {
-		return fmt.Errorf("error creating HTTP request: %s", err)
+		return diag.FromErr(err)
 	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+config.Token)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	statusCode, body, err := config.doRequest(ctx, http.MethodPut, "/api/public/provider/projects", project)
 	if err != nil {
-		return fmt.Errorf("error sending request to backend: %s", err)
+		return diag.FromErr(err)
 	}
-	defer resp.Body.Close()
-
-	responseBody, _ := io.ReadAll(resp.Body)
-	fmt.Printf("Response from backend: %s\n", string(responseBody))
-
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("creation failed, status code: %d, response: %s", resp.StatusCode, string(responseBody))
+	if classifyResponse(statusCode) != responseOK {
+		return diag.Diagnostics{responseDiagnostic("Unable to save project", statusCode, body)}
 	}
 
-	var responseData map[string]interface{}
-	if err := json.Unmarshal(responseBody, &responseData); err != nil {
-		return fmt.Errorf("error unmarshalling response: %s", err)
+	var envelope ProjectEnvelope
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return diag.Diagnostics{decodeDiagnostic("Unable to decode project response", err, body)}
 	}
-
-	if proj, ok := responseData["project"].(map[string]interface{}); ok {
-		if id, ok := proj["id"].(string); ok {
-			d.SetId(id)
-		} else {
-			return fmt.Errorf("project ID not found or invalid")
-		}
+	if envelope.Project == nil || envelope.Project.ID == "" {
+		return diag.Diagnostics{decodeDiagnostic("Unable to decode project response", fmt.Errorf("project ID not found in response"), body)}
 	}
+	d.SetId(envelope.Project.ID)
 
-	return resourceProjectRead(d, meta)
+	return resourceProjectRead(ctx, d, meta)
 }
 
-func resourceProjectRead(d *schema.ResourceData, meta interface{}) error {
+func resourceProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config, ok := meta.(*Config)
 	if !ok {
-		return fmt.Errorf("error converting meta to *Config")
+		return diag.Errorf("error converting meta to *Config")
 	}
 
-	url := fmt.Sprintf("%s/api/public/provider/projects", config.BackendURL)
-	req, err := http.NewRequest("GET", url, nil)
+	statusCode, body, err := config.doRequest(ctx, http.MethodGet, "/api/public/provider/projects", nil)
 	if err != nil {
-		return fmt.Errorf("error creating GET request: %s", err)
+		return diag.FromErr(err)
 	}
-	req.Header.Set("Authorization", "Bearer "+config.Token)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return fmt.Errorf("error sending GET request: %s", err)
+	switch classifyResponse(statusCode) {
+	case responseOK:
+	case responseNotFound:
+		log.Printf("[WARN] Project %q not found (status %d), removing from state", d.Id(), statusCode)
+		d.SetId("")
+		return nil
+	default:
+		return diag.Diagnostics{responseDiagnostic("Unable to read project", statusCode, body)}
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected response code: %d", resp.StatusCode)
+	var result ProjectListResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return diag.Diagnostics{decodeDiagnostic("Unable to decode project list", err, body)}
 	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("error reading response body: %s", err)
-	}
-
-	var responseData map[string]interface{}
-	if err := json.Unmarshal(body, &responseData); err != nil {
-		return fmt.Errorf("error unmarshalling JSON response: %s", err)
-	}
-
-	projects, ok := responseData["Projects"].([]interface{})
-	if !ok {
-		return fmt.Errorf("projects not found in response")
+	if result.Projects == nil {
+		return diag.Diagnostics{decodeDiagnostic("Unable to decode project list", fmt.Errorf("response does not contain Projects"), body)}
 	}
 
-	for _, p := range projects {
-		project, ok := p.(map[string]interface{})
-		if !ok {
+	for _, project := range *result.Projects {
+		if project.ID != d.Id() {
 			continue
 		}
-		if id, ok := project["id"].(string); ok && id == d.Id() {
-			if err := d.Set("organization", project["organization"]); err != nil {
-				return fmt.Errorf("error setting organization: %s", err)
-			}
-			if err := d.Set("name", project["name"]); err != nil {
-				return fmt.Errorf("error setting name: %s", err)
-			}
-			if err := d.Set("description", project["description"]); err != nil {
-				return fmt.Errorf("error setting description: %s", err)
-			}
-			return nil
+		if err := d.Set("organization", project.Organization); err != nil {
+			return diag.Errorf("error setting organization: %s", err)
+		}
+		if err := d.Set("name", project.Name); err != nil {
+			return diag.Errorf("error setting name: %s", err)
+		}
+		if err := d.Set("description", project.Description); err != nil {
+			return diag.Errorf("error setting description: %s", err)
+		}
+		if err := d.Set("tags", project.Tags); err != nil {
+			return diag.Errorf("error setting tags: %s", err)
 		}
+		configured, ok := d.Get("labels").(map[string]interface{})
+		if !ok {
+			return diag.Errorf("labels must be a map")
+		}
+		if err := d.Set("labels", configuredLabels(project.Labels, config.DefaultLabels, configured)); err != nil {
+			return diag.Errorf("error setting labels: %s", err)
+		}
+		if err := d.Set("all_labels", project.Labels); err != nil {
+			return diag.Errorf("error setting all_labels: %s", err)
+		}
+		if err := d.Set("archived", project.Archived); err != nil {
+			return diag.Errorf("error setting archived: %s", err)
+		}
+		// Backends that predate visibility omit it; they treat every
+		// project as private.
+		visibility := project.Visibility
+		if visibility == "" {
+			visibility = projectVisibilityPrivate
+		}
+		if err := d.Set("visibility", visibility); err != nil {
+			return diag.Errorf("error setting visibility: %s", err)
+		}
+		return nil
 	}
 
+	log.Printf("[WARN] Project %q not found in project list, removing from state", d.Id())
 	d.SetId("")
 	return nil
 }
 
-func resourceProjectUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceProjectCreate(d, meta)
+func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceProjectCreate(ctx, d, meta)
 }
 
-func resourceProjectDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config, ok := meta.(*Config)
 	if !ok {
-		return fmt.Errorf("error converting meta to *Config")
+		return diag.Errorf("error converting meta to *Config")
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("id is not set")
+		return diag.Errorf("id is not set")
+	}
+
+	deleteOnDestroy, ok := d.Get("delete_on_destroy").(bool)
+	if !ok {
+		return diag.Errorf("delete_on_destroy must be a bool")
+	}
+	if !deleteOnDestroy {
+		return resourceProjectArchive(ctx, d, config)
 	}
 
 	organization, ok := d.Get("organization").(string)
 	if !ok {
-		return fmt.Errorf("organization must be a string")
+		return diag.Errorf("organization must be a string")
 	}
 
 	payload := map[string]string{
@@ -200,28 +329,37 @@ func resourceProjectDelete(d *schema.ResourceData, meta interface{}) error {
 		"organization": organization,
 	}
 
-	body, err := json.Marshal(payload)
+	statusCode, body, err := config.doRequest(ctx, http.MethodDelete, "/api/public/provider/projects", payload)
 	if err != nil {
-		return fmt.Errorf("error marshalling payload to JSON: %s", err)
+		return diag.FromErr(err)
+	}
+	if !isDeleteSuccess(statusCode) {
+		return diag.Errorf("deletion failed, status code: %d, response: %s", statusCode, responseSnippet(body))
 	}
 
-	url := fmt.Sprintf("%s/api/public/provider/projects", config.BackendURL)
-	req, err := http.NewRequest("DELETE", url, bytes.NewBuffer(body))
+	d.SetId("")
+	return nil
+}
+
+// resourceProjectArchive retires the project on destroy when
+// delete_on_destroy is false, keeping its history on the backend.
+func resourceProjectArchive(ctx context.Context, d *schema.ResourceData, config *Config) diag.Diagnostics {
+	project, err := expandProject(d, config)
 	if err != nil {
-		return fmt.Errorf("error creating DELETE request: %s", err)
+		return diag.FromErr(err)
 	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+config.Token)
+	project.Archived = true
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	statusCode, body, err := config.doRequest(ctx, http.MethodPut, "/api/public/provider/projects", project)
 	if err != nil {
-		return fmt.Errorf("error sending DELETE request: %s", err)
+		return diag.FromErr(err)
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("deletion failed, status code: %d", resp.StatusCode)
+	switch classifyResponse(statusCode) {
+	case responseOK:
+	case responseNotFound:
+		log.Printf("[WARN] Project %q already gone, nothing to archive", d.Id())
+	default:
+		return diag.Diagnostics{responseDiagnostic("Unable to archive project", statusCode, body)}
 	}
 
 	d.SetId("")
@@ -233,5 +371,8 @@ func resourceProjectImport(d *schema.ResourceData, meta interface{}) ([]*schema.
 	if err := d.Set("organization", id); err != nil {
 		return nil, err
 	}
+	if err := d.Set("delete_on_destroy", true); err != nil {
+		return nil, err
+	}
 	return []*schema.ResourceData{d}, nil
 }
@@ -4,11 +4,18 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccProjectResource(t *testing.T) {
@@ -79,3 +86,183 @@ resource "goliatdashboard_project" "test" {
 		},
 	})
 }
+
+func TestResourceProjectDelete_Status(t *testing.T) {
+	cases := map[int]bool{
+		http.StatusOK:                  false,
+		http.StatusNoContent:           false,
+		http.StatusNotFound:            false,
+		http.StatusGone:                false,
+		http.StatusForbidden:           true,
+		http.StatusInternalServerError: true,
+	}
+
+	for status, wantErr := range cases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+			fmt.Fprint(w, "backend says no")
+		}))
+
+		d := schema.TestResourceDataRaw(t, resourceProject().Schema, map[string]interface{}{
+			"organization": "new_provider_org",
+			"name":         "Test Project",
+		})
+		d.SetId("project-1")
+
+		diags := resourceProjectDelete(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+		server.Close()
+
+		if wantErr {
+			assert.True(t, diags.HasError(), "status %d", status)
+			assert.Contains(t, diags[0].Summary, "backend says no", "status %d", status)
+			assert.Equal(t, "project-1", d.Id(), "status %d", status)
+		} else {
+			assert.False(t, diags.HasError(), "status %d", status)
+			assert.Empty(t, d.Id(), "status %d", status)
+		}
+	}
+}
+
+func loadFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("error reading fixture %s: %s", name, err)
+	}
+	return data
+}
+
+func TestProjectListResponse_Decode(t *testing.T) {
+	var result ProjectListResponse
+	assert.NoError(t, json.Unmarshal(loadFixture(t, "project_list.json"), &result))
+	if assert.NotNil(t, result.Projects) && assert.Len(t, *result.Projects, 2) {
+		assert.Equal(t, Project{
+			ID:           "6745b9c1e4b0a1d2c3f4a5b6",
+			Organization: "new_provider_org",
+			Name:         "Test Project",
+			Description:  "Initial description",
+		}, (*result.Projects)[0])
+	}
+}
+
+func TestProjectListResponse_DecodeNullable(t *testing.T) {
+	var result ProjectListResponse
+	assert.NoError(t, json.Unmarshal(loadFixture(t, "project_list_nullable.json"), &result))
+	if assert.NotNil(t, result.Projects) && assert.Len(t, *result.Projects, 1) {
+		project := (*result.Projects)[0]
+		assert.Equal(t, "1024", project.ID)
+		assert.Equal(t, "Legacy Project", project.Name)
+		assert.Empty(t, project.Description)
+	}
+}
+
+func TestProjectListResponse_DecodeInvalid(t *testing.T) {
+	var result ProjectListResponse
+	err := json.Unmarshal(loadFixture(t, "project_list_invalid.json"), &result)
+	assert.ErrorContains(t, err, "expected a string, number or null")
+}
+
+func TestProjectListResponse_DecodeMissingList(t *testing.T) {
+	var result ProjectListResponse
+	assert.NoError(t, json.Unmarshal([]byte(`{"message":"ok"}`), &result))
+	assert.Nil(t, result.Projects)
+}
+
+func TestProjectEnvelope_Decode(t *testing.T) {
+	var envelope ProjectEnvelope
+	assert.NoError(t, json.Unmarshal(loadFixture(t, "project_envelope.json"), &envelope))
+	if assert.NotNil(t, envelope.Project) {
+		assert.Equal(t, "6745b9c1e4b0a1d2c3f4a5b6", envelope.Project.ID)
+		assert.Equal(t, "Initial description", envelope.Project.Description)
+	}
+}
+
+func TestResourceProjectRead_Fixture(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadFixture(t, "project_list_nullable.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceProject().Schema, map[string]interface{}{
+		"organization": "new_provider_org",
+		"name":         "Legacy Project",
+		"description":  "stale",
+	})
+	d.SetId("1024")
+
+	diags := resourceProjectRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "1024", d.Id())
+	assert.Equal(t, "Legacy Project", d.Get("name"))
+	assert.Equal(t, "", d.Get("description"))
+}
+
+func TestResourceProjectRead_InvalidPayload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadFixture(t, "project_list_invalid.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceProject().Schema, map[string]interface{}{
+		"organization": "new_provider_org",
+		"name":         "Test Project",
+	})
+	d.SetId("6745b9c1e4b0a1d2c3f4a5b6")
+
+	diags := resourceProjectRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Detail, "description")
+	assert.Equal(t, "6745b9c1e4b0a1d2c3f4a5b6", d.Id())
+}
+
+func TestResourceProjectRead_Labels(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadFixture(t, "project_list_labels.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceProject().Schema, map[string]interface{}{
+		"organization": "new_provider_org",
+		"name":         "Test Project",
+		"labels":       map[string]interface{}{"cost_center": "cc-100"},
+	})
+	d.SetId("6745b9c1e4b0a1d2c3f4a5b6")
+
+	config := &Config{
+		BackendURL:    server.URL,
+		Token:         "test",
+		DefaultLabels: map[string]string{"team": "platform"},
+	}
+	diags := resourceProjectRead(context.Background(), d, config)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, map[string]interface{}{"cost_center": "cc-100"}, d.Get("labels"))
+	assert.Equal(t, map[string]interface{}{"team": "platform", "cost_center": "cc-100"}, d.Get("all_labels"))
+	assert.Equal(t, 2, d.Get("tags").(*schema.Set).Len()) //nolint:forcetypeassert
+}
+
+func TestResourceProjectDelete_Archive(t *testing.T) {
+	var method string
+	var payload Project
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		_, _ = w.Write(loadFixture(t, "project_envelope.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceProject().Schema, map[string]interface{}{
+		"organization":      "new_provider_org",
+		"name":              "Test Project",
+		"visibility":        "organization",
+		"delete_on_destroy": false,
+	})
+	d.SetId("6745b9c1e4b0a1d2c3f4a5b6")
+
+	diags := resourceProjectDelete(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Empty(t, d.Id())
+	assert.Equal(t, http.MethodPut, method)
+	assert.True(t, payload.Archived)
+	assert.Equal(t, "6745b9c1e4b0a1d2c3f4a5b6", payload.ID)
+	assert.Equal(t, "organization", payload.Visibility)
+}
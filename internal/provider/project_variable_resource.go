@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const projectVariablesPath = "/api/public/provider/project-variables"
+
+var projectVariableKeyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ProjectVariable is a key/value pair exposed to a project, optionally limited
+// to one environment. The backend omits Value for sensitive variables and
+// always reports ValueHash.
+type ProjectVariable struct {
+	ID            string `json:"id"`
+	Organization  string `json:"organization"`
+	ProjectID     string `json:"projectId"`
+	EnvironmentID string `json:"environmentId,omitempty"`
+	Key           string `json:"key"`
+	Value         string `json:"value,omitempty"`
+	Sensitive     bool   `json:"sensitive"`
+	ValueHash     string `json:"valueHash,omitempty"`
+}
+
+// ProjectVariableListResponse is the body returned when listing the project
+// variables of an organization.
+type ProjectVariableListResponse struct {
+	ProjectVariables *[]ProjectVariable `json:"ProjectVariables"`
+}
+
+// ProjectVariableEnvelope is the body returned when creating or updating a
+// project variable.
+type ProjectVariableEnvelope struct {
+	ProjectVariable *ProjectVariable `json:"projectVariable"`
+}
+
+func resourceProjectVariable() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProjectVariableCreate,
+		ReadContext:   resourceProjectVariableRead,
+		UpdateContext: resourceProjectVariableUpdate,
+		DeleteContext: resourceProjectVariableDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"environment": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(projectVariableKeyPattern, "must contain only letters, digits and underscores, and not start with a digit"),
+				),
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"value_wo": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				WriteOnly: true,
+				Sensitive: true,
+			},
+			"value_wo_version": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"sensitive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			"variable_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"value_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: resourceProjectVariableCustomizeDiff,
+	}
+}
+
+// resourceProjectVariableCustomizeDiff checks that sensitive variables use
+// value_wo and other variables use value, and marks value_hash as unknown
+// when a new value is sent.
+func resourceProjectVariableCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if config := d.GetRawConfig(); !config.IsNull() && config.IsKnown() && d.NewValueKnown("sensitive") {
+		sensitive, ok := d.Get("sensitive").(bool)
+		if !ok {
+			return fmt.Errorf("sensitive must be a bool")
+		}
+		if err := validateProjectVariableValue(sensitive, !config.GetAttr("value").IsNull(), !config.GetAttr("value_wo").IsNull()); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("value") || d.HasChange("value_wo_version") {
+		return d.SetNewComputed("value_hash")
+	}
+	return nil
+}
+
+// validateProjectVariableValue returns an error unless exactly the value
+// attribute matching sensitive is configured.
+func validateProjectVariableValue(sensitive, hasValue, hasValueWO bool) error {
+	switch {
+	case sensitive && hasValue:
+		return fmt.Errorf("value cannot be set for sensitive variables, use value_wo instead")
+	case sensitive && !hasValueWO:
+		return fmt.Errorf("value_wo must be set for sensitive variables")
+	case !sensitive && hasValueWO:
+		return fmt.Errorf("value_wo can only be set for sensitive variables, use value instead")
+	case !sensitive && !hasValue:
+		return fmt.Errorf("value must be set for variables that are not sensitive")
+	}
+	return nil
+}
+
+func expandProjectVariable(d *schema.ResourceData) (ProjectVariable, error) {
+	organization, ok := d.Get("organization").(string)
+	if !ok {
+		return ProjectVariable{}, fmt.Errorf("organization must be a string")
+	}
+	projectID, ok := d.Get("project_id").(string)
+	if !ok {
+		return ProjectVariable{}, fmt.Errorf("project_id must be a string")
+	}
+	environment, ok := d.Get("environment").(string)
+	if !ok {
+		return ProjectVariable{}, fmt.Errorf("environment must be a string")
+	}
+	key, ok := d.Get("key").(string)
+	if !ok {
+		return ProjectVariable{}, fmt.Errorf("key must be a string")
+	}
+	sensitive, ok := d.Get("sensitive").(bool)
+	if !ok {
+		return ProjectVariable{}, fmt.Errorf("sensitive must be a bool")
+	}
+	value, ok := d.Get("value").(string)
+	if !ok {
+		return ProjectVariable{}, fmt.Errorf("value must be a string")
+	}
+	if sensitive {
+		var err error
+		value, err = getWriteOnlyString(d, cty.GetAttrPath("value_wo"))
+		if err != nil {
+			return ProjectVariable{}, err
+		}
+	}
+	variableID, ok := d.Get("variable_id").(string)
+	if !ok {
+		return ProjectVariable{}, fmt.Errorf("variable_id must be a string")
+	}
+	return ProjectVariable{
+		ID:            variableID,
+		Organization:  organization,
+		ProjectID:     projectID,
+		EnvironmentID: environment,
+		Key:           key,
+		Value:         value,
+		Sensitive:     sensitive,
+	}, nil
+}
+
+func resourceProjectVariableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	variable, err := expandProjectVariable(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var envelope ProjectVariableEnvelope
+	if diags := config.writeJSON(ctx, http.MethodPut, projectVariablesPath, "Unable to create project variable", variable, &envelope); diags.HasError() {
+		return diags
+	}
+	if envelope.ProjectVariable == nil || envelope.ProjectVariable.ID == "" {
+		return diag.Errorf("project variable ID not found in response")
+	}
+
+	d.SetId(buildID(variable.Organization, envelope.ProjectVariable.ID))
+	return resourceProjectVariableRead(ctx, d, meta)
+}
+
+func resourceProjectVariableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/variable_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	org, variableID := parts[0], parts[1]
+
+	var result ProjectVariableListResponse
+	gone, diags := config.readJSON(ctx, organizationListPath(projectVariablesPath, org), "Unable to read project variables", &result)
+	if diags.HasError() {
+		return diags
+	}
+	if gone {
+		log.Printf("[WARN] Organization %q not found, removing project variable %q from state", org, variableID)
+		d.SetId("")
+		return nil
+	}
+	if result.ProjectVariables == nil {
+		return diag.Errorf("response does not contain ProjectVariables")
+	}
+
+	for _, variable := range *result.ProjectVariables {
+		if variable.ID != variableID {
+			continue
+		}
+		// Sensitive values are never returned and are written through
+		// value_wo, so value is only read back for other variables.
+		if !variable.Sensitive {
+			if err := d.Set("value", variable.Value); err != nil {
+				return diag.Errorf("error setting value: %s", err)
+			}
+		}
+		if err := d.Set("organization", org); err != nil {
+			return diag.Errorf("error setting organization: %s", err)
+		}
+		if err := d.Set("variable_id", variable.ID); err != nil {
+			return diag.Errorf("error setting variable_id: %s", err)
+		}
+		if err := d.Set("project_id", variable.ProjectID); err != nil {
+			return diag.Errorf("error setting project_id: %s", err)
+		}
+		if err := d.Set("environment", variable.EnvironmentID); err != nil {
+			return diag.Errorf("error setting environment: %s", err)
+		}
+		if err := d.Set("key", variable.Key); err != nil {
+			return diag.Errorf("error setting key: %s", err)
+		}
+		if err := d.Set("sensitive", variable.Sensitive); err != nil {
+			return diag.Errorf("error setting sensitive: %s", err)
+		}
+		if err := d.Set("value_hash", variable.ValueHash); err != nil {
+			return diag.Errorf("error setting value_hash: %s", err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] Project variable %q not found in organization %q, removing from state", variableID, org)
+	d.SetId("")
+	return nil
+}
+
+func resourceProjectVariableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	variable, err := expandProjectVariable(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := config.writeJSON(ctx, http.MethodPut, projectVariablesPath, "Unable to update project variable", variable, nil); diags.HasError() {
+		return diags
+	}
+	return resourceProjectVariableRead(ctx, d, meta)
+}
+
+func resourceProjectVariableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/variable_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	payload := map[string]string{
+		"id":           parts[1],
+		"organization": parts[0],
+	}
+	if diags := config.deleteJSON(ctx, projectVariablesPath, "Unable to delete project variable", payload); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
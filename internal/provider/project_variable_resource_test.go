@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// projectVariableValueHash returns the hash the backend reports for value:
+// the hex-encoded SHA-256 digest.
+func projectVariableValueHash(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestAccProjectVariableResource(t *testing.T) {
+	token := os.Getenv("EXAMPLE_TOKEN")
+	if token == "" {
+		t.Fatal("EXAMPLE_TOKEN is not set in environment variables")
+	}
+
+	config := func(password string, version int) string {
+		return `
+provider "goliatdashboard" {
+  backend_url = "https://demo.goliat-dashboard.com"
+  token       = "` + token + `"
+}
+
+resource "goliatdashboard_organization" "test_org" {
+  name = "new_provider_org"
+  type = "providerOrganizations"
+}
+
+resource "goliatdashboard_project" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  name         = "Test Project"
+}
+
+resource "goliatdashboard_environment" "production" {
+  organization = goliatdashboard_organization.test_org.name
+  project_id   = goliatdashboard_project.test.id
+  name         = "production"
+}
+
+resource "goliatdashboard_project_variable" "log_level" {
+  organization = goliatdashboard_organization.test_org.name
+  project_id   = goliatdashboard_project.test.id
+  key          = "LOG_LEVEL"
+  value        = "info"
+}
+
+resource "goliatdashboard_project_variable" "password" {
+  organization     = goliatdashboard_organization.test_org.name
+  project_id       = goliatdashboard_project.test.id
+  environment      = goliatdashboard_environment.production.environment_id
+  key              = "DATABASE_PASSWORD"
+  value_wo         = "` + password + `"
+  value_wo_version = ` + strconv.Itoa(version) + `
+  sensitive        = true
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"goliatdashboard": func() (*schema.Provider, error) { //nolint:unparam
+				return Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("first-password", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_project_variable.log_level", "value", "info"),
+					resource.TestCheckResourceAttr("goliatdashboard_project_variable.password", "value_hash", projectVariableValueHash("first-password")),
+				),
+			},
+			{
+				Config: config("second-password", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_project_variable.password", "value_hash", projectVariableValueHash("second-password")),
+				),
+			},
+			{
+				ResourceName:      "goliatdashboard_project_variable.log_level",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:            "goliatdashboard_project_variable.password",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value_wo_version"},
+			},
+		},
+	})
+}
+
+func TestResourceProjectVariableRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadFixture(t, "project_variable_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceProjectVariable().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org/variable_1")
+
+	diags := resourceProjectVariableRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "LOG_LEVEL", d.Get("key"))
+	assert.Equal(t, "debug", d.Get("value"))
+	assert.Equal(t, "", d.Get("environment"))
+	assert.Equal(t, projectVariableValueHash("debug"), d.Get("value_hash"))
+}
+
+func TestValidateProjectVariableValue(t *testing.T) {
+	assert.NoError(t, validateProjectVariableValue(false, true, false))
+	assert.NoError(t, validateProjectVariableValue(true, false, true))
+
+	assert.ErrorContains(t, validateProjectVariableValue(true, true, true), "value cannot be set for sensitive variables")
+	assert.ErrorContains(t, validateProjectVariableValue(true, false, false), "value_wo must be set for sensitive variables")
+	assert.ErrorContains(t, validateProjectVariableValue(false, true, true), "value_wo can only be set for sensitive variables")
+	assert.ErrorContains(t, validateProjectVariableValue(false, false, false), "value must be set")
+}
+
+func TestResourceProjectVariableCustomizeDiff(t *testing.T) {
+	config := func(attrs map[string]cty.Value) map[string]cty.Value {
+		attrs["organization"] = cty.StringVal("new_provider_org")
+		attrs["project_id"] = cty.StringVal("project_1")
+		attrs["key"] = cty.StringVal("DATABASE_PASSWORD")
+		return attrs
+	}
+
+	assert.NoError(t, planResource(t, resourceProjectVariable(), config(map[string]cty.Value{
+		"value_wo":  cty.StringVal("correct-horse-battery"),
+		"sensitive": cty.True,
+	})))
+	// The value comes from another resource and is only known at apply.
+	assert.NoError(t, planResource(t, resourceProjectVariable(), config(map[string]cty.Value{
+		"value_wo":  cty.UnknownVal(cty.String),
+		"sensitive": cty.True,
+	})))
+
+	err := planResource(t, resourceProjectVariable(), config(map[string]cty.Value{
+		"value":     cty.StringVal("correct-horse-battery"),
+		"sensitive": cty.True,
+	}))
+	assert.ErrorContains(t, err, "value cannot be set for sensitive variables")
+}
+
+func TestResourceProjectVariableRead_Sensitive(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadFixture(t, "project_variable_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceProjectVariable().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org/variable_2")
+
+	diags := resourceProjectVariableRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", d.Get("value"))
+	assert.Equal(t, "environment_2", d.Get("environment"))
+	assert.Equal(t, true, d.Get("sensitive"))
+	assert.Equal(t, projectVariableValueHash("correct-horse-battery"), d.Get("value_hash"))
+}
+
+func TestResourceProjectVariableCreate_ValueNotInState(t *testing.T) {
+	var sent ProjectVariable
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			_ = json.NewDecoder(r.Body).Decode(&sent)
+			_, _ = w.Write([]byte(`{"projectVariable":{"id":"variable_2"}}`))
+			return
+		}
+		_, _ = w.Write(loadFixture(t, "project_variable_list.json"))
+	}))
+	defer server.Close()
+
+	state, diags := applyResource(t, &Config{BackendURL: server.URL, Token: "test"}, "goliatdashboard_project_variable", map[string]cty.Value{
+		"organization":     cty.StringVal("new_provider_org"),
+		"project_id":       cty.StringVal("project_1"),
+		"environment":      cty.StringVal("environment_2"),
+		"key":              cty.StringVal("DATABASE_PASSWORD"),
+		"value_wo":         cty.StringVal("correct-horse-battery"),
+		"value_wo_version": cty.NumberIntVal(1),
+		"sensitive":        cty.True,
+	})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "correct-horse-battery", sent.Value)
+	assert.True(t, sent.Sensitive)
+
+	assert.True(t, state.GetAttr("value").IsNull())
+	assert.True(t, state.GetAttr("value_wo").IsNull())
+	assert.Equal(t, cty.NumberIntVal(1), state.GetAttr("value_wo_version"))
+	assert.Equal(t, projectVariableValueHash("correct-horse-battery"), state.GetAttr("value_hash").AsString())
+}
+
+func TestResourceProjectVariableUpdate(t *testing.T) {
+	var sent ProjectVariable
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			_ = json.NewDecoder(r.Body).Decode(&sent)
+			_, _ = w.Write([]byte(`{"projectVariable":{"id":"variable_1"}}`))
+			return
+		}
+		_, _ = w.Write(loadFixture(t, "project_variable_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceProjectVariable().Schema, map[string]interface{}{
+		"organization": "new_provider_org",
+		"project_id":   "project_1",
+		"key":          "LOG_LEVEL",
+		"value":        "debug",
+	})
+	d.SetId("new_provider_org/variable_1")
+	assert.NoError(t, d.Set("variable_id", "variable_1"))
+
+	diags := resourceProjectVariableUpdate(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "variable_1", sent.ID)
+	assert.Equal(t, "debug", sent.Value)
+	assert.False(t, sent.Sensitive)
+	assert.Empty(t, sent.ValueHash)
+	assert.Equal(t, "debug", d.Get("value"))
+}
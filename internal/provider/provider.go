@@ -4,14 +4,65 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 type Config struct {
-	BackendURL string
-	Token      string
+	BackendURL    string
+	Token         string
+	DefaultLabels map[string]string
+}
+
+// TokenOwner is the user the provider token was issued to.
+type TokenOwner struct {
+	UserID string `json:"userId"`
+	Email  string `json:"email"`
+}
+
+// tokenOwner looks up the user the provider token belongs to.
+func (c *Config) tokenOwner(ctx context.Context) (TokenOwner, diag.Diagnostics) {
+	var result struct {
+		User *TokenOwner `json:"user"`
+	}
+	gone, diags := c.readJSON(ctx, "/api/public/provider/me", "Unable to look up the provider token owner", &result)
+	if diags.HasError() {
+		return TokenOwner{}, diags
+	}
+	if gone || result.User == nil {
+		return TokenOwner{}, diag.Errorf("unable to look up the provider token owner: response does not contain user")
+	}
+	return *result.User, nil
+}
+
+// ProtoV6ProviderServerFactory serves the SDKv2 provider, upgraded to
+// protocol version 6, muxed with the framework provider that hosts ephemeral
+// resources.
+func ProtoV6ProviderServerFactory(ctx context.Context) (func() tfprotov6.ProviderServer, error) {
+	upgradedSdkServer, err := tf5to6server.UpgradeServer(ctx, Provider().GRPCProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := []func() tfprotov6.ProviderServer{
+		func() tfprotov6.ProviderServer {
+			return upgradedSdkServer
+		},
+		providerserver.NewProtocol6(newFrameworkProvider()),
+	}
+
+	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		return nil, err
+	}
+	return muxServer.ProviderServer, nil
 }
 
 func Provider() *schema.Provider {
@@ -26,10 +77,41 @@ func Provider() *schema.Provider {
 				Required:  true,
 				Sensitive: true,
 			},
+			"default_labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"goliatdashboard_organization": resourceOrganization(),
-			"goliatdashboard_project":      resourceProject(),
+			"goliatdashboard_alert_rule":            resourceAlertRule(),
+			"goliatdashboard_api_token":             resourceAPIToken(),
+			"goliatdashboard_dashboard":             resourceDashboard(),
+			"goliatdashboard_environment":           resourceEnvironment(),
+			"goliatdashboard_escalation_policy":     resourceEscalationPolicy(),
+			"goliatdashboard_http_check":            resourceHTTPCheck(),
+			"goliatdashboard_incident":              resourceIncident(),
+			"goliatdashboard_maintenance_window":    resourceMaintenanceWindow(),
+			"goliatdashboard_notification_channel":  resourceNotificationChannel(),
+			"goliatdashboard_organization":          resourceOrganization(),
+			"goliatdashboard_organization_member":   resourceOrganizationMember(),
+			"goliatdashboard_organization_members":  resourceOrganizationMembers(),
+			"goliatdashboard_organization_settings": resourceOrganizationSettings(),
+			"goliatdashboard_project":               resourceProject(),
+			"goliatdashboard_project_access":        resourceProjectAccess(),
+			"goliatdashboard_project_variable":      resourceProjectVariable(),
+			"goliatdashboard_role":                  resourceRole(),
+			"goliatdashboard_schedule":              resourceSchedule(),
+			"goliatdashboard_service":               resourceService(),
+			"goliatdashboard_slo":                   resourceSLO(),
+			"goliatdashboard_sso_provider":          resourceSSOProvider(),
+			"goliatdashboard_status_page":           resourceStatusPage(),
+			"goliatdashboard_team":                  resourceTeam(),
+			"goliatdashboard_team_membership":       resourceTeamMembership(),
+			"goliatdashboard_webhook":               resourceWebhook(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"goliatdashboard_incidents": dataSourceIncidents(),
 		},
 		ConfigureFunc: configureProvider,
 	}
@@ -44,8 +126,13 @@ func configureProvider(d *schema.ResourceData) (interface{}, error) {
 	if !ok {
 		return nil, fmt.Errorf("token must be a string")
 	}
+	defaultLabels, ok := d.Get("default_labels").(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("default_labels must be a map")
+	}
 	return &Config{
-		BackendURL: backendURL,
-		Token:      token,
+		BackendURL:    backendURL,
+		Token:         token,
+		DefaultLabels: expandStringMap(defaultLabels),
 	}, nil
 }
@@ -24,4 +24,7 @@ func TestProvider_Schema(t *testing.T) {
 
 	_, ok = schema["token"]
 	assert.True(t, ok, "The provider schema should contain 'token'")
+
+	_, ok = schema["default_labels"]
+	assert.True(t, ok, "The provider schema should contain 'default_labels'")
 }
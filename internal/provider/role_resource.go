@@ -0,0 +1,294 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	rolesPath       = "/api/public/provider/roles"
+	permissionsPath = "/api/public/provider/permissions"
+)
+
+type Role struct {
+	ID           string   `json:"id"`
+	Organization string   `json:"organization"`
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	Permissions  []string `json:"permissions"`
+}
+
+// RoleListResponse is the body returned when listing the custom roles of an
+// organization.
+type RoleListResponse struct {
+	Roles *[]Role `json:"Roles"`
+}
+
+// RoleEnvelope is the body returned when creating or updating a role.
+type RoleEnvelope struct {
+	Role *Role `json:"role"`
+}
+
+// PermissionListResponse is the body returned when listing the permissions
+// the backend understands.
+type PermissionListResponse struct {
+	Permissions *[]string `json:"Permissions"`
+}
+
+// permissionPattern matches permission strings such as "projects:read" or
+// "alerts:*".
+var permissionPattern = regexp.MustCompile(`^[a-z_]+:([a-z_]+|\*)$`)
+
+func resourceRole() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRoleCreate,
+		ReadContext:   resourceRoleRead,
+		UpdateContext: resourceRoleUpdate,
+		DeleteContext: resourceRoleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(permissionPattern, "must be a permission such as \"projects:read\""),
+				},
+			},
+			"role_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: resourceRoleCustomizeDiff,
+	}
+}
+
+// resourceRoleCustomizeDiff checks the planned permissions against the list
+// the backend supports, so typos fail at plan time rather than on apply.
+func resourceRoleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("permissions") || !d.NewValueKnown("permissions") {
+		return nil
+	}
+
+	config, ok := meta.(*Config)
+	if !ok {
+		return fmt.Errorf("error converting meta to *Config")
+	}
+	set, ok := d.Get("permissions").(*schema.Set)
+	if !ok {
+		return fmt.Errorf("permissions must be a set")
+	}
+
+	supported, diags := config.permissions(ctx)
+	if diags.HasError() {
+		return fmt.Errorf("%s: %s", diags[0].Summary, diags[0].Detail)
+	}
+	return validatePermissions(expandStringSet(set), supported)
+}
+
+// validatePermissions returns an error listing every permission that is not
+// in supported.
+func validatePermissions(permissions, supported []string) error {
+	known := make(map[string]bool, len(supported))
+	for _, p := range supported {
+		known[p] = true
+	}
+
+	var unknown []string
+	for _, p := range permissions {
+		if !known[p] {
+			unknown = append(unknown, p)
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("unsupported permissions %s; the backend supports: %s", strings.Join(unknown, ", "), strings.Join(supported, ", "))
+	}
+	return nil
+}
+
+// permissions lists the permission strings the backend supports.
+func (c *Config) permissions(ctx context.Context) ([]string, diag.Diagnostics) {
+	var result PermissionListResponse
+	gone, diags := c.readJSON(ctx, permissionsPath, "Unable to list permissions", &result)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if gone || result.Permissions == nil {
+		return nil, diag.Errorf("unable to list permissions: response does not contain Permissions")
+	}
+	return *result.Permissions, nil
+}
+
+func expandRole(d *schema.ResourceData) (Role, error) {
+	organization, ok := d.Get("organization").(string)
+	if !ok {
+		return Role{}, fmt.Errorf("organization must be a string")
+	}
+	name, ok := d.Get("name").(string)
+	if !ok {
+		return Role{}, fmt.Errorf("name must be a string")
+	}
+	description, ok := d.Get("description").(string)
+	if !ok {
+		return Role{}, fmt.Errorf("description must be a string")
+	}
+	permissions, ok := d.Get("permissions").(*schema.Set)
+	if !ok {
+		return Role{}, fmt.Errorf("permissions must be a set")
+	}
+	roleID, ok := d.Get("role_id").(string)
+	if !ok {
+		return Role{}, fmt.Errorf("role_id must be a string")
+	}
+	return Role{
+		ID:           roleID,
+		Organization: organization,
+		Name:         name,
+		Description:  description,
+		Permissions:  expandStringSet(permissions),
+	}, nil
+}
+
+func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	role, err := expandRole(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var envelope RoleEnvelope
+	if diags := config.writeJSON(ctx, http.MethodPut, rolesPath, "Unable to create role", role, &envelope); diags.HasError() {
+		return diags
+	}
+	if envelope.Role == nil || envelope.Role.ID == "" {
+		return diag.Errorf("role ID not found in response")
+	}
+
+	d.SetId(buildID(role.Organization, envelope.Role.ID))
+	return resourceRoleRead(ctx, d, meta)
+}
+
+func resourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/role_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	org, roleID := parts[0], parts[1]
+
+	var result RoleListResponse
+	gone, diags := config.readJSON(ctx, organizationListPath(rolesPath, org), "Unable to read roles", &result)
+	if diags.HasError() {
+		return diags
+	}
+	if gone {
+		log.Printf("[WARN] Organization %q not found, removing role %q from state", org, roleID)
+		d.SetId("")
+		return nil
+	}
+	if result.Roles == nil {
+		return diag.Errorf("response does not contain Roles")
+	}
+
+	for _, role := range *result.Roles {
+		if role.ID != roleID {
+			continue
+		}
+		if err := d.Set("organization", org); err != nil {
+			return diag.Errorf("error setting organization: %s", err)
+		}
+		if err := d.Set("role_id", role.ID); err != nil {
+			return diag.Errorf("error setting role_id: %s", err)
+		}
+		if err := d.Set("name", role.Name); err != nil {
+			return diag.Errorf("error setting name: %s", err)
+		}
+		if err := d.Set("description", role.Description); err != nil {
+			return diag.Errorf("error setting description: %s", err)
+		}
+		if err := d.Set("permissions", role.Permissions); err != nil {
+			return diag.Errorf("error setting permissions: %s", err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] Role %q not found in organization %q, removing from state", roleID, org)
+	d.SetId("")
+	return nil
+}
+
+func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	role, err := expandRole(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := config.writeJSON(ctx, http.MethodPut, rolesPath, "Unable to update role", role, nil); diags.HasError() {
+		return diags
+	}
+	return resourceRoleRead(ctx, d, meta)
+}
+
+func resourceRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	role, err := expandRole(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	payload := map[string]string{
+		"id":           role.ID,
+		"organization": role.Organization,
+	}
+	if diags := config.deleteJSON(ctx, rolesPath, "Unable to delete role", payload); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
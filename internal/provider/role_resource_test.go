@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePermissions(t *testing.T) {
+	supported := []string{"projects:read", "projects:write", "alerts:read"}
+
+	assert.NoError(t, validatePermissions([]string{"alerts:read", "projects:read"}, supported))
+
+	err := validatePermissions([]string{"projects:read", "project:write", "alerts:delete"}, supported)
+	assert.ErrorContains(t, err, "unsupported permissions project:write, alerts:delete")
+}
+
+func TestPermissionPattern(t *testing.T) {
+	for _, p := range []string{"projects:read", "audit_log:read", "alerts:*"} {
+		assert.True(t, permissionPattern.MatchString(p), p)
+	}
+	for _, p := range []string{"projects", "Projects:read", "projects:read:all", ":read"} {
+		assert.False(t, permissionPattern.MatchString(p), p)
+	}
+}
+
+func TestConfigPermissions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, permissionsPath, r.URL.Path)
+		_, _ = w.Write(loadFixture(t, "permission_list.json"))
+	}))
+	defer server.Close()
+
+	config := &Config{BackendURL: server.URL, Token: "test"}
+	permissions, diags := config.permissions(context.Background())
+	assert.False(t, diags.HasError())
+	assert.Contains(t, permissions, "audit_log:read")
+}
+
+func TestResourceRoleRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadFixture(t, "role_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceRole().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org/role_1")
+
+	diags := resourceRoleRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "auditor", d.Get("name"))
+	assert.Equal(t, "role_1", d.Get("role_id"))
+	assert.Equal(t, []string{"alerts:read", "audit_log:read", "projects:read"}, expandStringSet(d.Get("permissions").(*schema.Set))) //nolint:forcetypeassert
+}
@@ -0,0 +1,357 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const schedulesPath = "/api/public/provider/schedules"
+
+const (
+	scheduleRotationDaily  = "daily"
+	scheduleRotationWeekly = "weekly"
+)
+
+var scheduleHandoffDays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+// handoffTimePattern matches a 24-hour clock time such as "09:00".
+var handoffTimePattern = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// Schedule is an on-call rotation. Layers are evaluated in order and a later
+// layer overrides an earlier one while it has someone on call.
+type Schedule struct {
+	ID           string          `json:"id"`
+	Organization string          `json:"organization"`
+	Name         string          `json:"name"`
+	Description  string          `json:"description"`
+	Timezone     string          `json:"timezone"`
+	Layers       []ScheduleLayer `json:"layers"`
+}
+
+// ScheduleLayer rotates through UserIDs, handing off at HandoffTime in the
+// schedule time zone, every day or every week on HandoffDay.
+type ScheduleLayer struct {
+	Name         string   `json:"name"`
+	UserIDs      []string `json:"userIds"`
+	RotationType string   `json:"rotationType"`
+	HandoffTime  string   `json:"handoffTime"`
+	HandoffDay   string   `json:"handoffDay,omitempty"`
+}
+
+// ScheduleListResponse is the body returned when listing the schedules of an
+// organization.
+type ScheduleListResponse struct {
+	Schedules *[]Schedule `json:"Schedules"`
+}
+
+// ScheduleEnvelope is the body returned when creating or updating a schedule.
+type ScheduleEnvelope struct {
+	Schedule *Schedule `json:"schedule"`
+}
+
+func resourceSchedule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScheduleCreate,
+		ReadContext:   resourceScheduleRead,
+		UpdateContext: resourceScheduleUpdate,
+		DeleteContext: resourceScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"timezone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "UTC",
+				ValidateFunc: validateTimezone,
+			},
+			"layer": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"user_ids": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"rotation_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{scheduleRotationDaily, scheduleRotationWeekly}, false),
+						},
+						"handoff_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "09:00",
+							ValidateFunc: validation.StringMatch(handoffTimePattern, "must be a 24-hour time such as \"09:00\""),
+						},
+						"handoff_day": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(scheduleHandoffDays, false),
+						},
+					},
+				},
+			},
+			"schedule_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: resourceScheduleCustomizeDiff,
+	}
+}
+
+// resourceScheduleCustomizeDiff checks that handoff_day is set exactly for
+// weekly layers.
+func resourceScheduleCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("layer") {
+		return nil
+	}
+	raw, ok := d.Get("layer").([]interface{})
+	if !ok {
+		return fmt.Errorf("layer must be a list")
+	}
+	unknown := unknownConfigBlocks(d, "layer", "rotation_type", "handoff_day")
+	for i, layer := range expandScheduleLayers(raw) {
+		if unknown[i] {
+			continue
+		}
+		if err := validateScheduleLayer(layer); err != nil {
+			return fmt.Errorf("layer.%d (%q): %s", i, layer.Name, err)
+		}
+	}
+	return nil
+}
+
+func validateScheduleLayer(layer ScheduleLayer) error {
+	switch {
+	case layer.RotationType == scheduleRotationWeekly && layer.HandoffDay == "":
+		return fmt.Errorf("handoff_day must be set for %q rotations", scheduleRotationWeekly)
+	case layer.RotationType != scheduleRotationWeekly && layer.HandoffDay != "":
+		return fmt.Errorf("handoff_day cannot be set for %q rotations", layer.RotationType)
+	}
+	return nil
+}
+
+func expandScheduleLayers(raw []interface{}) []ScheduleLayer {
+	layers := make([]ScheduleLayer, 0, len(raw))
+	for _, item := range raw {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		var layer ScheduleLayer
+		layer.Name, _ = m["name"].(string)
+		layer.RotationType, _ = m["rotation_type"].(string)
+		layer.HandoffTime, _ = m["handoff_time"].(string)
+		layer.HandoffDay, _ = m["handoff_day"].(string)
+		userIDs, _ := m["user_ids"].([]interface{})
+		layer.UserIDs = make([]string, 0, len(userIDs))
+		for _, id := range userIDs {
+			if s, ok := id.(string); ok {
+				layer.UserIDs = append(layer.UserIDs, s)
+			}
+		}
+		layers = append(layers, layer)
+	}
+	return layers
+}
+
+func flattenScheduleLayers(layers []ScheduleLayer) []interface{} {
+	result := make([]interface{}, 0, len(layers))
+	for _, layer := range layers {
+		result = append(result, map[string]interface{}{
+			"name":          layer.Name,
+			"user_ids":      layer.UserIDs,
+			"rotation_type": layer.RotationType,
+			"handoff_time":  layer.HandoffTime,
+			"handoff_day":   layer.HandoffDay,
+		})
+	}
+	return result
+}
+
+func expandSchedule(d *schema.ResourceData) (Schedule, error) {
+	organization, ok := d.Get("organization").(string)
+	if !ok {
+		return Schedule{}, fmt.Errorf("organization must be a string")
+	}
+	name, ok := d.Get("name").(string)
+	if !ok {
+		return Schedule{}, fmt.Errorf("name must be a string")
+	}
+	description, ok := d.Get("description").(string)
+	if !ok {
+		return Schedule{}, fmt.Errorf("description must be a string")
+	}
+	timezone, ok := d.Get("timezone").(string)
+	if !ok {
+		return Schedule{}, fmt.Errorf("timezone must be a string")
+	}
+	layers, ok := d.Get("layer").([]interface{})
+	if !ok {
+		return Schedule{}, fmt.Errorf("layer must be a list")
+	}
+	scheduleID, ok := d.Get("schedule_id").(string)
+	if !ok {
+		return Schedule{}, fmt.Errorf("schedule_id must be a string")
+	}
+	return Schedule{
+		ID:           scheduleID,
+		Organization: organization,
+		Name:         name,
+		Description:  description,
+		Timezone:     timezone,
+		Layers:       expandScheduleLayers(layers),
+	}, nil
+}
+
+func resourceScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	schedule, err := expandSchedule(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var envelope ScheduleEnvelope
+	if diags := config.writeJSON(ctx, http.MethodPut, schedulesPath, "Unable to create schedule", schedule, &envelope); diags.HasError() {
+		return diags
+	}
+	if envelope.Schedule == nil || envelope.Schedule.ID == "" {
+		return diag.Errorf("schedule ID not found in response")
+	}
+
+	d.SetId(buildID(schedule.Organization, envelope.Schedule.ID))
+	return resourceScheduleRead(ctx, d, meta)
+}
+
+func resourceScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/schedule_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	org, scheduleID := parts[0], parts[1]
+
+	var result ScheduleListResponse
+	gone, diags := config.readJSON(ctx, organizationListPath(schedulesPath, org), "Unable to read schedules", &result)
+	if diags.HasError() {
+		return diags
+	}
+	if gone {
+		log.Printf("[WARN] Organization %q not found, removing schedule %q from state", org, scheduleID)
+		d.SetId("")
+		return nil
+	}
+	if result.Schedules == nil {
+		return diag.Errorf("response does not contain Schedules")
+	}
+
+	for _, schedule := range *result.Schedules {
+		if schedule.ID != scheduleID {
+			continue
+		}
+		if err := d.Set("organization", org); err != nil {
+			return diag.Errorf("error setting organization: %s", err)
+		}
+		if err := d.Set("schedule_id", schedule.ID); err != nil {
+			return diag.Errorf("error setting schedule_id: %s", err)
+		}
+		if err := d.Set("name", schedule.Name); err != nil {
+			return diag.Errorf("error setting name: %s", err)
+		}
+		if err := d.Set("description", schedule.Description); err != nil {
+			return diag.Errorf("error setting description: %s", err)
+		}
+		if err := d.Set("timezone", schedule.Timezone); err != nil {
+			return diag.Errorf("error setting timezone: %s", err)
+		}
+		if err := d.Set("layer", flattenScheduleLayers(schedule.Layers)); err != nil {
+			return diag.Errorf("error setting layer: %s", err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] Schedule %q not found in organization %q, removing from state", scheduleID, org)
+	d.SetId("")
+	return nil
+}
+
+func resourceScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	schedule, err := expandSchedule(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := config.writeJSON(ctx, http.MethodPut, schedulesPath, "Unable to update schedule", schedule, nil); diags.HasError() {
+		return diags
+	}
+	return resourceScheduleRead(ctx, d, meta)
+}
+
+func resourceScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/schedule_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	payload := map[string]string{
+		"id":           parts[1],
+		"organization": parts[0],
+	}
+	if diags := config.deleteJSON(ctx, schedulesPath, "Unable to delete schedule", payload); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
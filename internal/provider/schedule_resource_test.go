@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccScheduleResource(t *testing.T) {
+	token := os.Getenv("EXAMPLE_TOKEN")
+	if token == "" {
+		t.Fatal("EXAMPLE_TOKEN is not set in environment variables")
+	}
+
+	config := func(handoffDay string) string {
+		return `
+provider "goliatdashboard" {
+  backend_url = "https://demo.goliat-dashboard.com"
+  token       = "` + token + `"
+}
+
+resource "goliatdashboard_organization" "test_org" {
+  name = "new_provider_org"
+  type = "providerOrganizations"
+}
+
+resource "goliatdashboard_organization_member" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  email        = "member@goliat-dashboard.com"
+  role         = "member"
+}
+
+resource "goliatdashboard_schedule" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  name         = "Primary on-call"
+  timezone     = "Europe/Madrid"
+
+  layer {
+    name          = "Weekly"
+    user_ids      = [goliatdashboard_organization_member.test.user_id]
+    rotation_type = "weekly"
+    handoff_day   = "` + handoffDay + `"
+  }
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"goliatdashboard": func() (*schema.Provider, error) { //nolint:unparam
+				return Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("monday"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_schedule.test", "layer.0.handoff_time", "09:00"),
+					resource.TestCheckResourceAttrSet("goliatdashboard_schedule.test", "schedule_id"),
+				),
+			},
+			{
+				Config: config("friday"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_schedule.test", "layer.0.handoff_day", "friday"),
+				),
+			},
+			{
+				Config:      config(""),
+				ExpectError: regexp.MustCompile(`handoff_day must be set for "weekly" rotations`),
+			},
+			{
+				ResourceName:      "goliatdashboard_schedule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestValidateScheduleLayer(t *testing.T) {
+	assert.NoError(t, validateScheduleLayer(ScheduleLayer{RotationType: scheduleRotationWeekly, HandoffDay: "monday"}))
+	assert.NoError(t, validateScheduleLayer(ScheduleLayer{RotationType: scheduleRotationDaily}))
+	assert.ErrorContains(t, validateScheduleLayer(ScheduleLayer{RotationType: scheduleRotationWeekly}), "handoff_day must be set")
+	assert.ErrorContains(t, validateScheduleLayer(ScheduleLayer{RotationType: scheduleRotationDaily, HandoffDay: "monday"}), "handoff_day cannot be set")
+}
+
+func TestHandoffTimePattern(t *testing.T) {
+	for _, v := range []string{"00:00", "09:00", "18:30", "23:59"} {
+		assert.True(t, handoffTimePattern.MatchString(v), v)
+	}
+	for _, v := range []string{"9:00", "24:00", "12:60", "12:00:00", ""} {
+		assert.False(t, handoffTimePattern.MatchString(v), v)
+	}
+}
+
+func TestResourceScheduleCustomizeDiff_UnknownHandoffDay(t *testing.T) {
+	config := func(handoffDay cty.Value) map[string]cty.Value {
+		return map[string]cty.Value{
+			"organization": cty.StringVal("new_provider_org"),
+			"name":         cty.StringVal("Primary on-call"),
+			"layer": cty.TupleVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"name":          cty.StringVal("Weekly"),
+				"user_ids":      cty.ListVal([]cty.Value{cty.UnknownVal(cty.String)}),
+				"rotation_type": cty.StringVal("weekly"),
+				"handoff_day":   handoffDay,
+			})}),
+		}
+	}
+
+	assert.NoError(t, planResource(t, resourceSchedule(), config(cty.UnknownVal(cty.String))))
+
+	err := planResource(t, resourceSchedule(), config(cty.NullVal(cty.String)))
+	assert.ErrorContains(t, err, `layer.0 ("Weekly"): handoff_day must be set for "weekly" rotations`)
+}
+
+func TestResourceScheduleRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadFixture(t, "schedule_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceSchedule().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org/schedule_1")
+
+	diags := resourceScheduleRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "Europe/Madrid", d.Get("timezone"))
+	assert.Equal(t, 2, d.Get("layer.#"))
+	// Rotation order is significant and kept as returned.
+	assert.Equal(t, []interface{}{"user_2", "user_1", "user_3"}, d.Get("layer.0.user_ids"))
+	assert.Equal(t, "monday", d.Get("layer.0.handoff_day"))
+	assert.Equal(t, "daily", d.Get("layer.1.rotation_type"))
+	assert.Equal(t, "18:30", d.Get("layer.1.handoff_time"))
+	assert.Equal(t, "", d.Get("layer.1.handoff_day"))
+}
@@ -0,0 +1,416 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const servicesPath = "/api/public/provider/services"
+
+// Service is a monitored endpoint within a project. Which target fields are
+// used depends on Type.
+type Service struct {
+	ID              string `json:"id"`
+	Organization    string `json:"organization"`
+	ProjectID       string `json:"projectId"`
+	Name            string `json:"name"`
+	Type            string `json:"type"`
+	URL             string `json:"url,omitempty"`
+	Host            string `json:"host,omitempty"`
+	Port            int    `json:"port,omitempty"`
+	RecordType      string `json:"recordType,omitempty"`
+	IntervalSeconds int    `json:"intervalSeconds"`
+	TimeoutSeconds  int    `json:"timeoutSeconds"`
+	ExpectedStatus  int    `json:"expectedStatus,omitempty"`
+}
+
+// ServiceListResponse is the body returned when listing the services of an
+// organization.
+type ServiceListResponse struct {
+	Services *[]Service `json:"Services"`
+}
+
+// ServiceEnvelope is the body returned when creating or updating a service.
+type ServiceEnvelope struct {
+	Service *Service `json:"service"`
+}
+
+// serviceTypeFields lists, for each service type, the type-specific
+// attributes it accepts and whether each one is required.
+var serviceTypeFields = map[string]map[string]bool{
+	"http": {"url": true, "expected_status": false},
+	"tcp":  {"host": true, "port": true},
+	"dns":  {"host": true, "record_type": false},
+	"icmp": {"host": true},
+}
+
+// serviceTypeAttributes are the attributes that only apply to some service
+// types.
+var serviceTypeAttributes = []string{"url", "expected_status", "host", "port", "record_type"}
+
+func serviceTypes() []string {
+	types := make([]string, 0, len(serviceTypeFields))
+	for t := range serviceTypeFields {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+func resourceService() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServiceCreate,
+		ReadContext:   resourceServiceRead,
+		UpdateContext: resourceServiceUpdate,
+		DeleteContext: resourceServiceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(serviceTypes(), false),
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"url", "host"},
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"expected_status": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"host", "port", "record_type"},
+				ValidateFunc:  validation.IntBetween(100, 599),
+			},
+			"host": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"port": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"url", "record_type"},
+				ValidateFunc:  validation.IsPortNumber,
+			},
+			"record_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"url", "port"},
+				ValidateFunc:  validation.StringInSlice([]string{"A", "AAAA", "CNAME", "MX", "NS", "TXT"}, false),
+			},
+			"interval_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntAtLeast(10),
+			},
+			"timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"service_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: resourceServiceCustomizeDiff,
+	}
+}
+
+// resourceServiceCustomizeDiff checks the type-specific attributes against
+// the service type and that a check finishes before the next one starts.
+func resourceServiceCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	serviceType, ok := d.Get("type").(string)
+	if !ok {
+		return fmt.Errorf("type must be a string")
+	}
+	if d.NewValueKnown("type") {
+		configured := map[string]bool{}
+		for _, attr := range serviceTypeAttributes {
+			if !d.NewValueKnown(attr) {
+				// Unknown values may or may not end up set; assume the
+				// configuration is correct.
+				configured[attr] = true
+				continue
+			}
+			_, set := d.GetOk(attr)
+			configured[attr] = set
+		}
+		if err := validateServiceFields(serviceType, configured); err != nil {
+			return err
+		}
+	}
+
+	// Either value may come from another resource and be unknown until apply,
+	// when this check runs again.
+	if !d.NewValueKnown("interval_seconds") || !d.NewValueKnown("timeout_seconds") {
+		return nil
+	}
+	interval, ok := d.Get("interval_seconds").(int)
+	if !ok {
+		return fmt.Errorf("interval_seconds must be an integer")
+	}
+	timeout, ok := d.Get("timeout_seconds").(int)
+	if !ok {
+		return fmt.Errorf("timeout_seconds must be an integer")
+	}
+	if timeout >= interval {
+		return fmt.Errorf("timeout_seconds (%d) must be less than interval_seconds (%d)", timeout, interval)
+	}
+	return nil
+}
+
+// validateServiceFields returns an error if configured sets an attribute
+// serviceType does not accept, or misses one it requires.
+func validateServiceFields(serviceType string, configured map[string]bool) error {
+	fields := serviceTypeFields[serviceType]
+
+	var unsupported, missing []string
+	for _, attr := range serviceTypeAttributes {
+		required, accepted := fields[attr]
+		switch {
+		case configured[attr] && !accepted:
+			unsupported = append(unsupported, attr)
+		case !configured[attr] && required:
+			missing = append(missing, attr)
+		}
+	}
+	if len(unsupported) > 0 {
+		return fmt.Errorf("%s cannot be set for %q services", strings.Join(unsupported, ", "), serviceType)
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%s must be set for %q services", strings.Join(missing, ", "), serviceType)
+	}
+	return nil
+}
+
+func expandService(d *schema.ResourceData) (Service, error) {
+	organization, ok := d.Get("organization").(string)
+	if !ok {
+		return Service{}, fmt.Errorf("organization must be a string")
+	}
+	projectID, ok := d.Get("project_id").(string)
+	if !ok {
+		return Service{}, fmt.Errorf("project_id must be a string")
+	}
+	name, ok := d.Get("name").(string)
+	if !ok {
+		return Service{}, fmt.Errorf("name must be a string")
+	}
+	serviceType, ok := d.Get("type").(string)
+	if !ok {
+		return Service{}, fmt.Errorf("type must be a string")
+	}
+	url, ok := d.Get("url").(string)
+	if !ok {
+		return Service{}, fmt.Errorf("url must be a string")
+	}
+	expectedStatus, ok := d.Get("expected_status").(int)
+	if !ok {
+		return Service{}, fmt.Errorf("expected_status must be an integer")
+	}
+	host, ok := d.Get("host").(string)
+	if !ok {
+		return Service{}, fmt.Errorf("host must be a string")
+	}
+	port, ok := d.Get("port").(int)
+	if !ok {
+		return Service{}, fmt.Errorf("port must be an integer")
+	}
+	recordType, ok := d.Get("record_type").(string)
+	if !ok {
+		return Service{}, fmt.Errorf("record_type must be a string")
+	}
+	interval, ok := d.Get("interval_seconds").(int)
+	if !ok {
+		return Service{}, fmt.Errorf("interval_seconds must be an integer")
+	}
+	timeout, ok := d.Get("timeout_seconds").(int)
+	if !ok {
+		return Service{}, fmt.Errorf("timeout_seconds must be an integer")
+	}
+	serviceID, ok := d.Get("service_id").(string)
+	if !ok {
+		return Service{}, fmt.Errorf("service_id must be a string")
+	}
+	return Service{
+		ID:              serviceID,
+		Organization:    organization,
+		ProjectID:       projectID,
+		Name:            name,
+		Type:            serviceType,
+		URL:             url,
+		Host:            host,
+		Port:            port,
+		RecordType:      recordType,
+		IntervalSeconds: interval,
+		TimeoutSeconds:  timeout,
+		ExpectedStatus:  expectedStatus,
+	}, nil
+}
+
+func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	service, err := expandService(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var envelope ServiceEnvelope
+	if diags := config.writeJSON(ctx, http.MethodPut, servicesPath, "Unable to create service", service, &envelope); diags.HasError() {
+		return diags
+	}
+	if envelope.Service == nil || envelope.Service.ID == "" {
+		return diag.Errorf("service ID not found in response")
+	}
+
+	d.SetId(buildID(service.Organization, envelope.Service.ID))
+	return resourceServiceRead(ctx, d, meta)
+}
+
+func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/service_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	org, serviceID := parts[0], parts[1]
+
+	var result ServiceListResponse
+	gone, diags := config.readJSON(ctx, organizationListPath(servicesPath, org), "Unable to read services", &result)
+	if diags.HasError() {
+		return diags
+	}
+	if gone {
+		log.Printf("[WARN] Organization %q not found, removing service %q from state", org, serviceID)
+		d.SetId("")
+		return nil
+	}
+	if result.Services == nil {
+		return diag.Errorf("response does not contain Services")
+	}
+
+	for _, service := range *result.Services {
+		if service.ID != serviceID {
+			continue
+		}
+		if err := d.Set("organization", org); err != nil {
+			return diag.Errorf("error setting organization: %s", err)
+		}
+		if err := d.Set("service_id", service.ID); err != nil {
+			return diag.Errorf("error setting service_id: %s", err)
+		}
+		if err := d.Set("project_id", service.ProjectID); err != nil {
+			return diag.Errorf("error setting project_id: %s", err)
+		}
+		if err := d.Set("name", service.Name); err != nil {
+			return diag.Errorf("error setting name: %s", err)
+		}
+		if err := d.Set("type", service.Type); err != nil {
+			return diag.Errorf("error setting type: %s", err)
+		}
+		if err := d.Set("url", service.URL); err != nil {
+			return diag.Errorf("error setting url: %s", err)
+		}
+		if err := d.Set("expected_status", service.ExpectedStatus); err != nil {
+			return diag.Errorf("error setting expected_status: %s", err)
+		}
+		if err := d.Set("host", service.Host); err != nil {
+			return diag.Errorf("error setting host: %s", err)
+		}
+		if err := d.Set("port", service.Port); err != nil {
+			return diag.Errorf("error setting port: %s", err)
+		}
+		if err := d.Set("record_type", service.RecordType); err != nil {
+			return diag.Errorf("error setting record_type: %s", err)
+		}
+		if err := d.Set("interval_seconds", service.IntervalSeconds); err != nil {
+			return diag.Errorf("error setting interval_seconds: %s", err)
+		}
+		if err := d.Set("timeout_seconds", service.TimeoutSeconds); err != nil {
+			return diag.Errorf("error setting timeout_seconds: %s", err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] Service %q not found in organization %q, removing from state", serviceID, org)
+	d.SetId("")
+	return nil
+}
+
+func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	service, err := expandService(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := config.writeJSON(ctx, http.MethodPut, servicesPath, "Unable to update service", service, nil); diags.HasError() {
+		return diags
+	}
+	return resourceServiceRead(ctx, d, meta)
+}
+
+func resourceServiceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/service_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	payload := map[string]string{
+		"id":           parts[1],
+		"organization": parts[0],
+	}
+	if diags := config.deleteJSON(ctx, servicesPath, "Unable to delete service", payload); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
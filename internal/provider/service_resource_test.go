@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccServiceResource(t *testing.T) {
+	token := os.Getenv("EXAMPLE_TOKEN")
+	if token == "" {
+		t.Fatal("EXAMPLE_TOKEN is not set in environment variables")
+	}
+
+	config := func(interval string) string {
+		return `
+provider "goliatdashboard" {
+  backend_url = "https://demo.goliat-dashboard.com"
+  token       = "` + token + `"
+}
+
+resource "goliatdashboard_organization" "test_org" {
+  name = "new_provider_org"
+  type = "providerOrganizations"
+}
+
+resource "goliatdashboard_project" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  name         = "Test Project"
+}
+
+resource "goliatdashboard_service" "test" {
+  organization     = goliatdashboard_organization.test_org.name
+  project_id       = goliatdashboard_project.test.id
+  name             = "API"
+  type             = "http"
+  url              = "https://demo.goliat-dashboard.com/health"
+  expected_status  = 200
+  interval_seconds = ` + interval + `
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"goliatdashboard": func() (*schema.Provider, error) { //nolint:unparam
+				return Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("60"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_service.test", "type", "http"),
+					resource.TestCheckResourceAttr("goliatdashboard_service.test", "interval_seconds", "60"),
+					resource.TestCheckResourceAttrSet("goliatdashboard_service.test", "service_id"),
+				),
+			},
+			{
+				Config: config("30"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_service.test", "interval_seconds", "30"),
+				),
+			},
+			{
+				ResourceName:      "goliatdashboard_service.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestValidateServiceFields(t *testing.T) {
+	assert.NoError(t, validateServiceFields("http", map[string]bool{"url": true, "expected_status": true}))
+	assert.NoError(t, validateServiceFields("tcp", map[string]bool{"host": true, "port": true}))
+	assert.NoError(t, validateServiceFields("dns", map[string]bool{"host": true}))
+
+	assert.ErrorContains(t, validateServiceFields("tcp", map[string]bool{"host": true}), `port must be set for "tcp" services`)
+	assert.ErrorContains(t, validateServiceFields("icmp", map[string]bool{"host": true, "record_type": true}), `record_type cannot be set for "icmp" services`)
+	assert.ErrorContains(t, validateServiceFields("http", map[string]bool{"host": true}), `host cannot be set for "http" services`)
+}
+
+func TestResourceServiceCustomizeDiff_UnknownInterval(t *testing.T) {
+	config := func(interval cty.Value) map[string]cty.Value {
+		return map[string]cty.Value{
+			"organization":     cty.StringVal("new_provider_org"),
+			"project_id":       cty.StringVal("prj_1"),
+			"name":             cty.StringVal("api"),
+			"type":             cty.StringVal("http"),
+			"url":              cty.StringVal("https://api.example.com"),
+			"interval_seconds": interval,
+			"timeout_seconds":  cty.NumberIntVal(30),
+		}
+	}
+
+	// The interval comes from another resource and is only known at apply.
+	assert.NoError(t, planResource(t, resourceService(), config(cty.UnknownVal(cty.Number))))
+
+	err := planResource(t, resourceService(), config(cty.NumberIntVal(30)))
+	assert.ErrorContains(t, err, "timeout_seconds (30) must be less than interval_seconds (30)")
+}
+
+func TestResourceServiceRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "new_provider_org", r.URL.Query().Get("organization"))
+		_, _ = w.Write(loadFixture(t, "service_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceService().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org/service_2")
+
+	diags := resourceServiceRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "service_2", d.Get("service_id"))
+	assert.Equal(t, "tcp", d.Get("type"))
+	assert.Equal(t, "db.example.com", d.Get("host"))
+	assert.Equal(t, 5432, d.Get("port"))
+	assert.Equal(t, "", d.Get("url"))
+}
+
+func TestResourceServiceRead_Removed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Services":[]}`))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceService().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org/service_1")
+
+	diags := resourceServiceRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Empty(t, d.Id())
+}
+
+func TestResourceServiceUpdate(t *testing.T) {
+	var sent Service
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			_ = json.NewDecoder(r.Body).Decode(&sent)
+			_, _ = w.Write([]byte(`{"service":{"id":"service_1"}}`))
+			return
+		}
+		_, _ = w.Write(loadFixture(t, "service_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceService().Schema, map[string]interface{}{
+		"organization":     "new_provider_org",
+		"project_id":       "project_1",
+		"name":             "API",
+		"type":             "http",
+		"url":              "https://api.example.com/health",
+		"expected_status":  204,
+		"interval_seconds": 30,
+		"timeout_seconds":  5,
+	})
+	d.SetId("new_provider_org/service_1")
+	assert.NoError(t, d.Set("service_id", "service_1"))
+
+	diags := resourceServiceUpdate(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "service_1", sent.ID)
+	assert.Equal(t, 204, sent.ExpectedStatus)
+	assert.Equal(t, 30, sent.IntervalSeconds)
+	assert.Empty(t, sent.Host)
+}
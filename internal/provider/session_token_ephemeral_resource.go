@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	sessionTokensPath = "/api/public/provider/session-tokens"
+
+	// sessionTokenDefaultTTL is used when the configuration does not set ttl.
+	sessionTokenDefaultTTL = 15 * time.Minute
+
+	// sessionTokenPrivateKey stores the token ID between Open and Close.
+	sessionTokenPrivateKey = "session_token"
+)
+
+// SessionToken is a short-lived credential exchanged for the provider token.
+type SessionToken struct {
+	ID           string   `json:"id"`
+	Organization string   `json:"organization"`
+	ProjectID    string   `json:"projectId,omitempty"`
+	Scopes       []string `json:"scopes,omitempty"`
+	TTLSeconds   int64    `json:"ttlSeconds"`
+	Token        string   `json:"token,omitempty"`
+	ExpiresAt    string   `json:"expiresAt,omitempty"`
+}
+
+// SessionTokenEnvelope is the body returned when a session token is issued.
+type SessionTokenEnvelope struct {
+	SessionToken *SessionToken `json:"sessionToken"`
+}
+
+// sessionTokenEphemeralResource issues a session token when opened and
+// revokes it when closed. The token never reaches plan or state.
+type sessionTokenEphemeralResource struct {
+	config *Config
+}
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure = &sessionTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &sessionTokenEphemeralResource{}
+)
+
+type sessionTokenModel struct {
+	Organization types.String `tfsdk:"organization"`
+	ProjectID    types.String `tfsdk:"project_id"`
+	Scopes       types.Set    `tfsdk:"scopes"`
+	TTL          types.String `tfsdk:"ttl"`
+	Token        types.String `tfsdk:"token"`
+	ExpiresAt    types.String `tfsdk:"expires_at"`
+}
+
+// sessionTokenPrivate is the private data handed from Open to Close.
+type sessionTokenPrivate struct {
+	ID           string `json:"id"`
+	Organization string `json:"organization"`
+}
+
+func newSessionTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &sessionTokenEphemeralResource{}
+}
+
+func (r *sessionTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_session_token"
+}
+
+func (r *sessionTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Required: true,
+			},
+			"project_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"scopes": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"ttl": schema.StringAttribute{
+				Optional:   true,
+				Validators: []validator.String{durationValidator{}},
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expires_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *sessionTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("expected *Config, got %T", req.ProviderData))
+		return
+	}
+	r.config = config
+}
+
+func (r *sessionTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.config == nil {
+		resp.Diagnostics.AddError("Unconfigured provider", "the provider must be configured before issuing a session token")
+		return
+	}
+	var model sessionTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ttl := sessionTokenDefaultTTL
+	if !model.TTL.IsNull() {
+		var err error
+		ttl, err = time.ParseDuration(model.TTL.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("ttl"), "Invalid ttl", err.Error())
+			return
+		}
+	}
+
+	var scopes []string
+	if !model.Scopes.IsNull() {
+		resp.Diagnostics.Append(model.Scopes.ElementsAs(ctx, &scopes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	request := SessionToken{
+		Organization: model.Organization.ValueString(),
+		ProjectID:    model.ProjectID.ValueString(),
+		Scopes:       scopes,
+		TTLSeconds:   int64(ttl.Seconds()),
+	}
+
+	var envelope SessionTokenEnvelope
+	resp.Diagnostics.Append(frameworkDiagnostics(r.config.writeJSON(ctx, http.MethodPost, sessionTokensPath, "Unable to issue session token", request, &envelope))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if envelope.SessionToken == nil || envelope.SessionToken.Token == "" {
+		resp.Diagnostics.AddError("Unable to issue session token", "response does not contain sessionToken")
+		return
+	}
+
+	model.Token = types.StringValue(envelope.SessionToken.Token)
+	model.ExpiresAt = types.StringValue(envelope.SessionToken.ExpiresAt)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+
+	private, err := json.Marshal(sessionTokenPrivate{
+		ID:           envelope.SessionToken.ID,
+		Organization: request.Organization,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to store session token ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, sessionTokenPrivateKey, private)...)
+}
+
+// Close revokes the session token as soon as Terraform no longer needs it,
+// rather than waiting for it to expire.
+func (r *sessionTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	if r.config == nil {
+		resp.Diagnostics.AddError("Unconfigured provider", "the provider must be configured before revoking a session token")
+		return
+	}
+	data, diags := req.Private.GetKey(ctx, sessionTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || data == nil {
+		return
+	}
+
+	var private sessionTokenPrivate
+	if err := json.Unmarshal(data, &private); err != nil {
+		resp.Diagnostics.AddError("Unable to read session token ID", err.Error())
+		return
+	}
+	if private.ID == "" {
+		return
+	}
+
+	resp.Diagnostics.Append(frameworkDiagnostics(r.config.deleteJSON(ctx, sessionTokensPath, "Unable to revoke session token", private))...)
+}
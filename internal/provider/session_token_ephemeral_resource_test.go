@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtoV6ProviderServerFactory_Schema(t *testing.T) {
+	ctx := context.Background()
+	factory, err := ProtoV6ProviderServerFactory(ctx)
+	require.NoError(t, err)
+
+	// The mux server reports an error if the SDKv2 and framework provider
+	// schemas ever drift apart.
+	resp, err := factory().GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Diagnostics)
+	assert.Contains(t, resp.EphemeralResourceSchemas, "goliatdashboard_session_token")
+	assert.Contains(t, resp.ResourceSchemas, "goliatdashboard_project")
+}
+
+func TestSessionTokenEphemeralResource_OpenClose(t *testing.T) {
+	var issued SessionToken
+	var revoked sessionTokenPrivate
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			_ = json.NewDecoder(r.Body).Decode(&issued)
+			_, _ = w.Write([]byte(`{"sessionToken":{"id":"st_1","token":"gd_session","expiresAt":"2999-01-01T00:15:00Z"}}`))
+		case http.MethodDelete:
+			_ = json.NewDecoder(r.Body).Decode(&revoked)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	factory, err := ProtoV6ProviderServerFactory(ctx)
+	require.NoError(t, err)
+	providerServer := factory()
+	ephemeralServer, ok := providerServer.(tfprotov6.EphemeralResourceServer)
+	require.True(t, ok, "provider server does not serve ephemeral resources")
+
+	providerType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"backend_url":    tftypes.String,
+		"token":          tftypes.String,
+		"default_labels": tftypes.Map{ElementType: tftypes.String},
+	}}
+	providerConfig, err := tfprotov6.NewDynamicValue(providerType, tftypes.NewValue(providerType, map[string]tftypes.Value{
+		"backend_url":    tftypes.NewValue(tftypes.String, server.URL),
+		"token":          tftypes.NewValue(tftypes.String, "test"),
+		"default_labels": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+	}))
+	require.NoError(t, err)
+
+	configureResp, err := providerServer.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: &providerConfig})
+	require.NoError(t, err)
+	require.Empty(t, configureResp.Diagnostics)
+
+	tokenType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"organization": tftypes.String,
+		"project_id":   tftypes.String,
+		"scopes":       tftypes.Set{ElementType: tftypes.String},
+		"ttl":          tftypes.String,
+		"token":        tftypes.String,
+		"expires_at":   tftypes.String,
+	}}
+	tokenConfig, err := tfprotov6.NewDynamicValue(tokenType, tftypes.NewValue(tokenType, map[string]tftypes.Value{
+		"organization": tftypes.NewValue(tftypes.String, "new_provider_org"),
+		"project_id":   tftypes.NewValue(tftypes.String, nil),
+		"scopes": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "projects:read"),
+		}),
+		"ttl":        tftypes.NewValue(tftypes.String, "5m"),
+		"token":      tftypes.NewValue(tftypes.String, nil),
+		"expires_at": tftypes.NewValue(tftypes.String, nil),
+	}))
+	require.NoError(t, err)
+
+	openResp, err := ephemeralServer.OpenEphemeralResource(ctx, &tfprotov6.OpenEphemeralResourceRequest{
+		TypeName: "goliatdashboard_session_token",
+		Config:   &tokenConfig,
+	})
+	require.NoError(t, err)
+	require.Empty(t, openResp.Diagnostics)
+	assert.Equal(t, "new_provider_org", issued.Organization)
+	assert.Equal(t, int64(300), issued.TTLSeconds)
+	assert.Equal(t, []string{"projects:read"}, issued.Scopes)
+
+	result, err := openResp.Result.Unmarshal(tokenType)
+	require.NoError(t, err)
+	var values map[string]tftypes.Value
+	require.NoError(t, result.As(&values))
+	var token string
+	require.NoError(t, values["token"].As(&token))
+	assert.Equal(t, "gd_session", token)
+
+	closeResp, err := ephemeralServer.CloseEphemeralResource(ctx, &tfprotov6.CloseEphemeralResourceRequest{
+		TypeName: "goliatdashboard_session_token",
+		Private:  openResp.Private,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, closeResp.Diagnostics)
+	assert.Equal(t, sessionTokenPrivate{ID: "st_1", Organization: "new_provider_org"}, revoked)
+}
+
+func TestSessionTokenEphemeralResource_Unconfigured(t *testing.T) {
+	ctx := context.Background()
+	r := &sessionTokenEphemeralResource{}
+
+	var openResp ephemeral.OpenResponse
+	r.Open(ctx, ephemeral.OpenRequest{}, &openResp)
+	if assert.True(t, openResp.Diagnostics.HasError()) {
+		assert.Equal(t, "Unconfigured provider", openResp.Diagnostics[0].Summary())
+	}
+
+	var closeResp ephemeral.CloseResponse
+	r.Close(ctx, ephemeral.CloseRequest{}, &closeResp)
+	if assert.True(t, closeResp.Diagnostics.HasError()) {
+		assert.Equal(t, "Unconfigured provider", closeResp.Diagnostics[0].Summary())
+	}
+}
@@ -0,0 +1,332 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const slosPath = "/api/public/provider/slos"
+
+const (
+	sloIndicatorAvailability = "availability"
+	sloIndicatorLatency      = "latency"
+)
+
+// SLO is a service level objective. Attainment and ErrorBudgetRemaining are
+// reported by the backend and never sent.
+type SLO struct {
+	ID                   string       `json:"id"`
+	Organization         string       `json:"organization"`
+	ServiceID            string       `json:"serviceId"`
+	Name                 string       `json:"name"`
+	Target               float64      `json:"target"`
+	Window               string       `json:"window"`
+	Indicator            SLOIndicator `json:"indicator"`
+	Attainment           *float64     `json:"attainment,omitempty"`
+	ErrorBudgetRemaining *float64     `json:"errorBudgetRemaining,omitempty"`
+}
+
+// SLOIndicator defines what counts as a good event.
+type SLOIndicator struct {
+	Type        string `json:"type"`
+	ThresholdMS int    `json:"thresholdMs,omitempty"`
+}
+
+// SLOListResponse is the body returned when listing the SLOs of an
+// organization.
+type SLOListResponse struct {
+	SLOs *[]SLO `json:"Slos"`
+}
+
+// SLOEnvelope is the body returned when creating or updating an SLO.
+type SLOEnvelope struct {
+	SLO *SLO `json:"slo"`
+}
+
+func resourceSLO() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSLOCreate,
+		ReadContext:   resourceSLORead,
+		UpdateContext: resourceSLOUpdate,
+		DeleteContext: resourceSLODelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"target": {
+				Type:         schema.TypeFloat,
+				Required:     true,
+				ValidateFunc: validation.FloatBetween(0.001, 99.999),
+			},
+			"window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "28d",
+				ValidateFunc: validation.StringInSlice([]string{"7d", "28d", "30d"}, false),
+			},
+			"indicator": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{sloIndicatorAvailability, sloIndicatorLatency}, false),
+						},
+						"threshold_ms": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"slo_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attainment": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"error_budget_remaining": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: resourceSLOCustomizeDiff,
+	}
+}
+
+// resourceSLOCustomizeDiff checks that threshold_ms is set exactly when the
+// indicator measures latency.
+func resourceSLOCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("indicator") || unknownConfigBlocks(d, "indicator", "type", "threshold_ms")[0] {
+		return nil
+	}
+	return validateSLOIndicator(expandSLOIndicator(d.Get("indicator")))
+}
+
+func validateSLOIndicator(indicator SLOIndicator) error {
+	switch {
+	case indicator.Type == sloIndicatorLatency && indicator.ThresholdMS == 0:
+		return fmt.Errorf("indicator.0.threshold_ms must be set for %q indicators", sloIndicatorLatency)
+	case indicator.Type != sloIndicatorLatency && indicator.ThresholdMS != 0:
+		return fmt.Errorf("indicator.0.threshold_ms cannot be set for %q indicators", indicator.Type)
+	}
+	return nil
+}
+
+func expandSLOIndicator(v interface{}) SLOIndicator {
+	var indicator SLOIndicator
+	m := firstBlock(v)
+	if m == nil {
+		return indicator
+	}
+	indicator.Type, _ = m["type"].(string)
+	indicator.ThresholdMS, _ = m["threshold_ms"].(int)
+	return indicator
+}
+
+func flattenSLOIndicator(indicator SLOIndicator) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"type":         indicator.Type,
+		"threshold_ms": indicator.ThresholdMS,
+	}}
+}
+
+func expandSLO(d *schema.ResourceData) (SLO, error) {
+	organization, ok := d.Get("organization").(string)
+	if !ok {
+		return SLO{}, fmt.Errorf("organization must be a string")
+	}
+	serviceID, ok := d.Get("service_id").(string)
+	if !ok {
+		return SLO{}, fmt.Errorf("service_id must be a string")
+	}
+	name, ok := d.Get("name").(string)
+	if !ok {
+		return SLO{}, fmt.Errorf("name must be a string")
+	}
+	target, ok := d.Get("target").(float64)
+	if !ok {
+		return SLO{}, fmt.Errorf("target must be a number")
+	}
+	window, ok := d.Get("window").(string)
+	if !ok {
+		return SLO{}, fmt.Errorf("window must be a string")
+	}
+	sloID, ok := d.Get("slo_id").(string)
+	if !ok {
+		return SLO{}, fmt.Errorf("slo_id must be a string")
+	}
+	return SLO{
+		ID:           sloID,
+		Organization: organization,
+		ServiceID:    serviceID,
+		Name:         name,
+		Target:       target,
+		Window:       window,
+		Indicator:    expandSLOIndicator(d.Get("indicator")),
+	}, nil
+}
+
+func resourceSLOCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	slo, err := expandSLO(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var envelope SLOEnvelope
+	if diags := config.writeJSON(ctx, http.MethodPut, slosPath, "Unable to create SLO", slo, &envelope); diags.HasError() {
+		return diags
+	}
+	if envelope.SLO == nil || envelope.SLO.ID == "" {
+		return diag.Errorf("SLO ID not found in response")
+	}
+
+	d.SetId(buildID(slo.Organization, envelope.SLO.ID))
+	return resourceSLORead(ctx, d, meta)
+}
+
+func resourceSLORead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/slo_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	org, sloID := parts[0], parts[1]
+
+	var result SLOListResponse
+	gone, diags := config.readJSON(ctx, organizationListPath(slosPath, org), "Unable to read SLOs", &result)
+	if diags.HasError() {
+		return diags
+	}
+	if gone {
+		log.Printf("[WARN] Organization %q not found, removing SLO %q from state", org, sloID)
+		d.SetId("")
+		return nil
+	}
+	if result.SLOs == nil {
+		return diag.Errorf("response does not contain Slos")
+	}
+
+	for _, slo := range *result.SLOs {
+		if slo.ID != sloID {
+			continue
+		}
+		if err := d.Set("organization", org); err != nil {
+			return diag.Errorf("error setting organization: %s", err)
+		}
+		if err := d.Set("slo_id", slo.ID); err != nil {
+			return diag.Errorf("error setting slo_id: %s", err)
+		}
+		if err := d.Set("service_id", slo.ServiceID); err != nil {
+			return diag.Errorf("error setting service_id: %s", err)
+		}
+		if err := d.Set("name", slo.Name); err != nil {
+			return diag.Errorf("error setting name: %s", err)
+		}
+		if err := d.Set("target", slo.Target); err != nil {
+			return diag.Errorf("error setting target: %s", err)
+		}
+		if err := d.Set("window", slo.Window); err != nil {
+			return diag.Errorf("error setting window: %s", err)
+		}
+		if err := d.Set("indicator", flattenSLOIndicator(slo.Indicator)); err != nil {
+			return diag.Errorf("error setting indicator: %s", err)
+		}
+		// The live figures are only refreshed here. They are computed-only
+		// and never marked unknown on update, so they never cause a diff.
+		// A new SLO has no data yet and the previous values are kept.
+		if slo.Attainment != nil {
+			if err := d.Set("attainment", *slo.Attainment); err != nil {
+				return diag.Errorf("error setting attainment: %s", err)
+			}
+		}
+		if slo.ErrorBudgetRemaining != nil {
+			if err := d.Set("error_budget_remaining", *slo.ErrorBudgetRemaining); err != nil {
+				return diag.Errorf("error setting error_budget_remaining: %s", err)
+			}
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] SLO %q not found in organization %q, removing from state", sloID, org)
+	d.SetId("")
+	return nil
+}
+
+func resourceSLOUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	slo, err := expandSLO(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := config.writeJSON(ctx, http.MethodPut, slosPath, "Unable to update SLO", slo, nil); diags.HasError() {
+		return diags
+	}
+	return resourceSLORead(ctx, d, meta)
+}
+
+func resourceSLODelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/slo_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	payload := map[string]string{
+		"id":           parts[1],
+		"organization": parts[0],
+	}
+	if diags := config.deleteJSON(ctx, slosPath, "Unable to delete SLO", payload); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
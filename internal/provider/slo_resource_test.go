@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccSLOResource(t *testing.T) {
+	token := os.Getenv("EXAMPLE_TOKEN")
+	if token == "" {
+		t.Fatal("EXAMPLE_TOKEN is not set in environment variables")
+	}
+
+	config := func(target string) string {
+		return `
+provider "goliatdashboard" {
+  backend_url = "https://demo.goliat-dashboard.com"
+  token       = "` + token + `"
+}
+
+resource "goliatdashboard_organization" "test_org" {
+  name = "new_provider_org"
+  type = "providerOrganizations"
+}
+
+resource "goliatdashboard_project" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  name         = "Test Project"
+}
+
+resource "goliatdashboard_service" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  project_id   = goliatdashboard_project.test.id
+  name         = "API"
+  type         = "http"
+  url          = "https://demo.goliat-dashboard.com/health"
+}
+
+resource "goliatdashboard_slo" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  service_id   = goliatdashboard_service.test.service_id
+  name         = "API availability"
+  target       = ` + target + `
+
+  indicator {
+    type = "availability"
+  }
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"goliatdashboard": func() (*schema.Provider, error) { //nolint:unparam
+				return Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("99.9"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_slo.test", "window", "28d"),
+					resource.TestCheckResourceAttrSet("goliatdashboard_slo.test", "slo_id"),
+				),
+			},
+			{
+				// Refreshing the live figures must not produce a plan.
+				Config:   config("99.9"),
+				PlanOnly: true,
+			},
+			{
+				Config: config("99.5"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_slo.test", "target", "99.5"),
+				),
+			},
+			{
+				ResourceName:            "goliatdashboard_slo.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"attainment", "error_budget_remaining"},
+			},
+		},
+	})
+}
+
+func TestValidateSLOIndicator(t *testing.T) {
+	assert.NoError(t, validateSLOIndicator(SLOIndicator{Type: sloIndicatorAvailability}))
+	assert.NoError(t, validateSLOIndicator(SLOIndicator{Type: sloIndicatorLatency, ThresholdMS: 300}))
+	assert.ErrorContains(t, validateSLOIndicator(SLOIndicator{Type: sloIndicatorLatency}), "threshold_ms must be set")
+	assert.ErrorContains(t, validateSLOIndicator(SLOIndicator{Type: sloIndicatorAvailability, ThresholdMS: 300}), "threshold_ms cannot be set")
+}
+
+func TestResourceSLOCustomizeDiff_UnknownThreshold(t *testing.T) {
+	config := func(threshold cty.Value) map[string]cty.Value {
+		return map[string]cty.Value{
+			"organization": cty.StringVal("new_provider_org"),
+			"service_id":   cty.StringVal("svc_1"),
+			"name":         cty.StringVal("api-latency"),
+			"target":       cty.NumberFloatVal(99.5),
+			"indicator": cty.TupleVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"type":         cty.StringVal(sloIndicatorLatency),
+					"threshold_ms": threshold,
+				}),
+			}),
+		}
+	}
+
+	// The threshold comes from another resource and is only known at apply.
+	assert.NoError(t, planResource(t, resourceSLO(), config(cty.UnknownVal(cty.Number))))
+
+	err := planResource(t, resourceSLO(), config(cty.NullVal(cty.Number)))
+	assert.ErrorContains(t, err, `indicator.0.threshold_ms must be set for "latency" indicators`)
+}
+
+func TestResourceSLORead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadFixture(t, "slo_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceSLO().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org/slo_1")
+
+	diags := resourceSLORead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 99.5, d.Get("target"))
+	assert.Equal(t, "latency", d.Get("indicator.0.type"))
+	assert.Equal(t, 300, d.Get("indicator.0.threshold_ms"))
+	assert.Equal(t, 99.72, d.Get("attainment"))
+	assert.Equal(t, 44.1, d.Get("error_budget_remaining"))
+}
+
+func TestResourceSLOUpdate_OmitsLiveFigures(t *testing.T) {
+	var sent map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			_ = json.NewDecoder(r.Body).Decode(&sent)
+			_, _ = w.Write([]byte(`{"slo":{"id":"slo_2"}}`))
+			return
+		}
+		_, _ = w.Write(loadFixture(t, "slo_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceSLO().Schema, map[string]interface{}{
+		"organization": "new_provider_org",
+		"service_id":   "service_1",
+		"name":         "API availability",
+		"target":       99.9,
+		"window":       "7d",
+		"indicator": []interface{}{
+			map[string]interface{}{"type": "availability"},
+		},
+	})
+	d.SetId("new_provider_org/slo_2")
+	assert.NoError(t, d.Set("slo_id", "slo_2"))
+	assert.NoError(t, d.Set("attainment", 99.95))
+
+	diags := resourceSLOUpdate(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.NotContains(t, sent, "attainment")
+	assert.NotContains(t, sent, "errorBudgetRemaining")
+	assert.Equal(t, "slo_2", sent["id"])
+	// The backend has no figures for this SLO yet; the last known value stays.
+	assert.Equal(t, 99.95, d.Get("attainment"))
+}
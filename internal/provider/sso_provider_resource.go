@@ -0,0 +1,537 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const ssoProvidersPath = "/api/public/provider/sso-providers"
+
+var ssoProtocols = []string{"saml", "oidc"}
+
+// SSOProvider is an external identity provider members sign in through.
+// Exactly one of SAML and OIDC is set, matching Protocol.
+type SSOProvider struct {
+	ID               string              `json:"id"`
+	Organization     string              `json:"organization"`
+	Name             string              `json:"name"`
+	Protocol         string              `json:"protocol"`
+	SAML             *SAMLSettings       `json:"saml,omitempty"`
+	OIDC             *OIDCSettings       `json:"oidc,omitempty"`
+	AttributeMapping SSOAttributeMapping `json:"attributeMapping"`
+	CallbackURL      string              `json:"callbackUrl,omitempty"`
+}
+
+// SAMLSettings points at the identity provider metadata, either inline or by
+// URL.
+type SAMLSettings struct {
+	MetadataXML string `json:"metadataXml,omitempty"`
+	MetadataURL string `json:"metadataUrl,omitempty"`
+}
+
+// OIDCSettings identifies the dashboard as an OIDC client. The backend never
+// returns ClientSecret.
+type OIDCSettings struct {
+	Issuer       string `json:"issuer"`
+	ClientID     string `json:"clientId"`
+	ClientSecret string `json:"clientSecret,omitempty"`
+}
+
+// SSOAttributeMapping names the assertion attributes or claims that hold
+// each user field.
+type SSOAttributeMapping struct {
+	Email     string `json:"email"`
+	FirstName string `json:"firstName,omitempty"`
+	LastName  string `json:"lastName,omitempty"`
+	Groups    string `json:"groups,omitempty"`
+}
+
+// SSOProviderListResponse is the body returned when listing the SSO
+// providers of an organization.
+type SSOProviderListResponse struct {
+	SSOProviders *[]SSOProvider `json:"SsoProviders"`
+}
+
+// SSOProviderEnvelope is the body returned when creating or updating an SSO
+// provider.
+type SSOProviderEnvelope struct {
+	SSOProvider *SSOProvider `json:"ssoProvider"`
+}
+
+func resourceSSOProvider() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSSOProviderCreate,
+		ReadContext:   resourceSSOProviderRead,
+		UpdateContext: resourceSSOProviderUpdate,
+		DeleteContext: resourceSSOProviderDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ssoProtocols, false),
+			},
+			"saml": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: ssoProtocols,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metadata_xml": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"saml.0.metadata_xml", "saml.0.metadata_url"},
+							ValidateFunc: validateSAMLMetadata,
+						},
+						"metadata_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+					},
+				},
+			},
+			"oidc": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"issuer": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateOIDCIssuer,
+						},
+						"client_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"client_secret_wo": {
+							Type:      schema.TypeString,
+							Required:  true,
+							WriteOnly: true,
+							Sensitive: true,
+						},
+						"client_secret_wo_version": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"attribute_mapping": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "email",
+						},
+						"first_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"last_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"groups": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"provider_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"callback_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: resourceSSOProviderCustomizeDiff,
+	}
+}
+
+// resourceSSOProviderCustomizeDiff checks that the settings block matches
+// protocol.
+func resourceSSOProviderCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("protocol") {
+		return nil
+	}
+	protocol, ok := d.Get("protocol").(string)
+	if !ok {
+		return fmt.Errorf("protocol must be a string")
+	}
+	for _, p := range ssoProtocols {
+		if p == protocol || !d.NewValueKnown(p) {
+			continue
+		}
+		if blocks, ok := d.Get(p).([]interface{}); ok && len(blocks) > 0 {
+			return fmt.Errorf("a %q block cannot be used with protocol %q; use a %q block instead", p, protocol, protocol)
+		}
+	}
+	return nil
+}
+
+// samlEntityDescriptor is the part of SAML 2.0 metadata the provider checks.
+type samlEntityDescriptor struct {
+	XMLName           xml.Name               `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+	EntityID          string                 `xml:"entityID,attr"`
+	IDPSSODescriptors []samlIDPSSODescriptor `xml:"urn:oasis:names:tc:SAML:2.0:metadata IDPSSODescriptor"`
+}
+
+type samlIDPSSODescriptor struct {
+	KeyDescriptors       []samlKeyDescriptor `xml:"urn:oasis:names:tc:SAML:2.0:metadata KeyDescriptor"`
+	SingleSignOnServices []samlEndpoint      `xml:"urn:oasis:names:tc:SAML:2.0:metadata SingleSignOnService"`
+}
+
+type samlKeyDescriptor struct {
+	Use          string   `xml:"use,attr"`
+	Certificates []string `xml:"KeyInfo>X509Data>X509Certificate"`
+}
+
+type samlEndpoint struct {
+	Binding  string `xml:"Binding,attr"`
+	Location string `xml:"Location,attr"`
+}
+
+// validateSAMLMetadata is a schema.SchemaValidateFunc that accepts SAML 2.0
+// identity provider metadata.
+func validateSAMLMetadata(v interface{}, k string) ([]string, []error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if err := parseSAMLMetadata(s); err != nil {
+		return nil, []error{fmt.Errorf("%s: invalid SAML metadata: %s", k, err)}
+	}
+	return nil, nil
+}
+
+// parseSAMLMetadata checks, without fetching anything, that metadata is an
+// EntityDescriptor for an identity provider with an entity ID, a single
+// sign-on endpoint and a signing certificate.
+func parseSAMLMetadata(metadata string) error {
+	var entity samlEntityDescriptor
+	if err := xml.Unmarshal([]byte(metadata), &entity); err != nil {
+		return err
+	}
+	if entity.EntityID == "" {
+		return fmt.Errorf("EntityDescriptor has no entityID")
+	}
+	if len(entity.IDPSSODescriptors) == 0 {
+		return fmt.Errorf("EntityDescriptor has no IDPSSODescriptor; is this service provider metadata?")
+	}
+	idp := entity.IDPSSODescriptors[0]
+	if len(idp.SingleSignOnServices) == 0 {
+		return fmt.Errorf("IDPSSODescriptor has no SingleSignOnService")
+	}
+	for _, sso := range idp.SingleSignOnServices {
+		if u, err := url.Parse(sso.Location); err != nil || !u.IsAbs() || u.Host == "" {
+			return fmt.Errorf("SingleSignOnService Location %q is not an absolute URL", sso.Location)
+		}
+	}
+	signing := 0
+	for _, key := range idp.KeyDescriptors {
+		if key.Use != "" && key.Use != "signing" {
+			continue
+		}
+		for _, cert := range key.Certificates {
+			der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(cert), ""))
+			if err != nil {
+				return fmt.Errorf("X509Certificate is not valid base64: %s", err)
+			}
+			if _, err := x509.ParseCertificate(der); err != nil {
+				return fmt.Errorf("X509Certificate: %s", err)
+			}
+			signing++
+		}
+	}
+	if signing == 0 {
+		return fmt.Errorf("IDPSSODescriptor has no signing X509Certificate")
+	}
+	return nil
+}
+
+// validateOIDCIssuer is a schema.SchemaValidateFunc that accepts OIDC issuer
+// identifiers.
+func validateOIDCIssuer(v interface{}, k string) ([]string, []error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if err := parseOIDCIssuer(s); err != nil {
+		return nil, []error{fmt.Errorf("%s: invalid OIDC issuer %q: %s", k, s, err)}
+	}
+	return nil, nil
+}
+
+// parseOIDCIssuer checks, without fetching the discovery document, that
+// issuer is a valid OpenID Connect issuer identifier: an https URL with a
+// host and no query or fragment.
+func parseOIDCIssuer(issuer string) error {
+	u, err := url.Parse(issuer)
+	if err != nil {
+		return err
+	}
+	switch {
+	case u.Scheme != "https":
+		return fmt.Errorf("scheme must be https")
+	case u.Host == "":
+		return fmt.Errorf("host is missing")
+	case u.User != nil:
+		return fmt.Errorf("must not contain user information")
+	case u.RawQuery != "" || u.ForceQuery:
+		return fmt.Errorf("must not contain a query")
+	case u.Fragment != "" || strings.Contains(issuer, "#"):
+		return fmt.Errorf("must not contain a fragment")
+	case strings.HasSuffix(u.Path, "/.well-known/openid-configuration"):
+		return fmt.Errorf("use the issuer, not the discovery document URL")
+	}
+	return nil
+}
+
+func expandSSOAttributeMapping(v interface{}) SSOAttributeMapping {
+	mapping := SSOAttributeMapping{Email: "email"}
+	m := firstBlock(v)
+	if m == nil {
+		return mapping
+	}
+	mapping.Email, _ = m["email"].(string)
+	mapping.FirstName, _ = m["first_name"].(string)
+	mapping.LastName, _ = m["last_name"].(string)
+	mapping.Groups, _ = m["groups"].(string)
+	return mapping
+}
+
+func flattenSSOAttributeMapping(mapping SSOAttributeMapping) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"email":      mapping.Email,
+		"first_name": mapping.FirstName,
+		"last_name":  mapping.LastName,
+		"groups":     mapping.Groups,
+	}}
+}
+
+func expandSSOProvider(d *schema.ResourceData) (SSOProvider, error) {
+	organization, ok := d.Get("organization").(string)
+	if !ok {
+		return SSOProvider{}, fmt.Errorf("organization must be a string")
+	}
+	name, ok := d.Get("name").(string)
+	if !ok {
+		return SSOProvider{}, fmt.Errorf("name must be a string")
+	}
+	protocol, ok := d.Get("protocol").(string)
+	if !ok {
+		return SSOProvider{}, fmt.Errorf("protocol must be a string")
+	}
+	providerID, ok := d.Get("provider_id").(string)
+	if !ok {
+		return SSOProvider{}, fmt.Errorf("provider_id must be a string")
+	}
+	provider := SSOProvider{
+		ID:               providerID,
+		Organization:     organization,
+		Name:             name,
+		Protocol:         protocol,
+		AttributeMapping: expandSSOAttributeMapping(d.Get("attribute_mapping")),
+	}
+
+	if m := firstBlock(d.Get("saml")); m != nil {
+		provider.SAML = &SAMLSettings{}
+		provider.SAML.MetadataXML, _ = m["metadata_xml"].(string)
+		provider.SAML.MetadataURL, _ = m["metadata_url"].(string)
+	}
+	if m := firstBlock(d.Get("oidc")); m != nil {
+		secret, err := getWriteOnlyString(d, cty.GetAttrPath("oidc").IndexInt(0).GetAttr("client_secret_wo"))
+		if err != nil {
+			return SSOProvider{}, err
+		}
+		provider.OIDC = &OIDCSettings{ClientSecret: secret}
+		provider.OIDC.Issuer, _ = m["issuer"].(string)
+		provider.OIDC.ClientID, _ = m["client_id"].(string)
+	}
+	return provider, nil
+}
+
+// flattenSSOProviderSettings converts the settings returned by the backend
+// into blocks. The client secret is write-only and never stored; only its
+// version is carried over from the current state.
+func flattenSSOProviderSettings(d *schema.ResourceData, provider SSOProvider) (saml, oidc []interface{}) {
+	if provider.SAML != nil {
+		saml = []interface{}{map[string]interface{}{
+			"metadata_xml": provider.SAML.MetadataXML,
+			"metadata_url": provider.SAML.MetadataURL,
+		}}
+	}
+	if provider.OIDC != nil {
+		oidc = []interface{}{map[string]interface{}{
+			"issuer":                   provider.OIDC.Issuer,
+			"client_id":                provider.OIDC.ClientID,
+			"client_secret_wo_version": d.Get("oidc.0.client_secret_wo_version"),
+		}}
+	}
+	return saml, oidc
+}
+
+func resourceSSOProviderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	provider, err := expandSSOProvider(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var envelope SSOProviderEnvelope
+	if diags := config.writeJSON(ctx, http.MethodPut, ssoProvidersPath, "Unable to create SSO provider", provider, &envelope); diags.HasError() {
+		return diags
+	}
+	if envelope.SSOProvider == nil || envelope.SSOProvider.ID == "" {
+		return diag.Errorf("SSO provider ID not found in response")
+	}
+
+	d.SetId(buildID(provider.Organization, envelope.SSOProvider.ID))
+	return resourceSSOProviderRead(ctx, d, meta)
+}
+
+func resourceSSOProviderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/provider_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	org, providerID := parts[0], parts[1]
+
+	var result SSOProviderListResponse
+	gone, diags := config.readJSON(ctx, organizationListPath(ssoProvidersPath, org), "Unable to read SSO providers", &result)
+	if diags.HasError() {
+		return diags
+	}
+	if gone {
+		log.Printf("[WARN] Organization %q not found, removing SSO provider %q from state", org, providerID)
+		d.SetId("")
+		return nil
+	}
+	if result.SSOProviders == nil {
+		return diag.Errorf("response does not contain SsoProviders")
+	}
+
+	for _, provider := range *result.SSOProviders {
+		if provider.ID != providerID {
+			continue
+		}
+		saml, oidc := flattenSSOProviderSettings(d, provider)
+		if err := d.Set("organization", org); err != nil {
+			return diag.Errorf("error setting organization: %s", err)
+		}
+		if err := d.Set("provider_id", provider.ID); err != nil {
+			return diag.Errorf("error setting provider_id: %s", err)
+		}
+		if err := d.Set("name", provider.Name); err != nil {
+			return diag.Errorf("error setting name: %s", err)
+		}
+		if err := d.Set("protocol", provider.Protocol); err != nil {
+			return diag.Errorf("error setting protocol: %s", err)
+		}
+		if err := d.Set("saml", saml); err != nil {
+			return diag.Errorf("error setting saml: %s", err)
+		}
+		if err := d.Set("oidc", oidc); err != nil {
+			return diag.Errorf("error setting oidc: %s", err)
+		}
+		if err := d.Set("attribute_mapping", flattenSSOAttributeMapping(provider.AttributeMapping)); err != nil {
+			return diag.Errorf("error setting attribute_mapping: %s", err)
+		}
+		if err := d.Set("callback_url", provider.CallbackURL); err != nil {
+			return diag.Errorf("error setting callback_url: %s", err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] SSO provider %q not found in organization %q, removing from state", providerID, org)
+	d.SetId("")
+	return nil
+}
+
+func resourceSSOProviderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	provider, err := expandSSOProvider(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := config.writeJSON(ctx, http.MethodPut, ssoProvidersPath, "Unable to update SSO provider", provider, nil); diags.HasError() {
+		return diags
+	}
+	return resourceSSOProviderRead(ctx, d, meta)
+}
+
+func resourceSSOProviderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/provider_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	payload := map[string]string{
+		"id":           parts[1],
+		"organization": parts[0],
+	}
+	if diags := config.deleteJSON(ctx, ssoProvidersPath, "Unable to delete SSO provider", payload); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,394 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const statusPagesPath = "/api/public/provider/status-pages"
+
+var (
+	statusPageSlugPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+	hexColorPattern       = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+)
+
+// StatusPage is a public page showing the health of a list of components.
+// Components are displayed in the order given.
+type StatusPage struct {
+	ID           string                `json:"id"`
+	Organization string                `json:"organization"`
+	Slug         string                `json:"slug"`
+	Title        string                `json:"title"`
+	CustomDomain string                `json:"customDomain,omitempty"`
+	Components   []StatusPageComponent `json:"components"`
+	Theme        *StatusPageTheme      `json:"theme,omitempty"`
+	URL          string                `json:"url,omitempty"`
+}
+
+// StatusPageComponent shows the health of a service or a whole project.
+type StatusPageComponent struct {
+	DisplayName string `json:"displayName"`
+	ServiceID   string `json:"serviceId,omitempty"`
+	ProjectID   string `json:"projectId,omitempty"`
+	Group       string `json:"group,omitempty"`
+}
+
+// StatusPageTheme customizes the look of a status page.
+type StatusPageTheme struct {
+	PrimaryColor string `json:"primaryColor,omitempty"`
+	LogoURL      string `json:"logoUrl,omitempty"`
+	Mode         string `json:"mode,omitempty"`
+}
+
+// StatusPageListResponse is the body returned when listing the status pages
+// of an organization.
+type StatusPageListResponse struct {
+	StatusPages *[]StatusPage `json:"StatusPages"`
+}
+
+// StatusPageEnvelope is the body returned when creating or updating a status
+// page.
+type StatusPageEnvelope struct {
+	StatusPage *StatusPage `json:"statusPage"`
+}
+
+func resourceStatusPage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceStatusPageCreate,
+		ReadContext:   resourceStatusPageRead,
+		UpdateContext: resourceStatusPageUpdate,
+		DeleteContext: resourceStatusPageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(statusPageSlugPattern, "must contain only lowercase letters, digits and hyphens, and must not start or end with a hyphen"),
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"custom_domain": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(domainNamePattern, "must be a lowercase domain name such as \"status.example.com\""),
+			},
+			"component": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"display_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"service_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"project_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"group": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"theme": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"primary_color": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(hexColorPattern, "must be a hex color such as \"#1a73e8\""),
+						},
+						"logo_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "auto",
+							ValidateFunc: validation.StringInSlice([]string{"auto", "light", "dark"}, false),
+						},
+					},
+				},
+			},
+			"page_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: resourceStatusPageCustomizeDiff,
+	}
+}
+
+// resourceStatusPageCustomizeDiff checks that every component references
+// exactly one service or project. Components whose references are not known
+// yet are checked at apply.
+func resourceStatusPageCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("component") {
+		return nil
+	}
+	raw, ok := d.Get("component").([]interface{})
+	if !ok {
+		return fmt.Errorf("component must be a list")
+	}
+	unknown := unknownConfigBlocks(d, "component", "service_id", "project_id")
+	for i, c := range expandStatusPageComponents(raw) {
+		if unknown[i] {
+			continue
+		}
+		if (c.ServiceID == "") == (c.ProjectID == "") {
+			return fmt.Errorf("component.%d (%q): exactly one of service_id and project_id must be set", i, c.DisplayName)
+		}
+	}
+	return nil
+}
+
+func expandStatusPageComponents(raw []interface{}) []StatusPageComponent {
+	components := make([]StatusPageComponent, 0, len(raw))
+	for _, item := range raw {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		var c StatusPageComponent
+		c.DisplayName, _ = m["display_name"].(string)
+		c.ServiceID, _ = m["service_id"].(string)
+		c.ProjectID, _ = m["project_id"].(string)
+		c.Group, _ = m["group"].(string)
+		components = append(components, c)
+	}
+	return components
+}
+
+func flattenStatusPageComponents(components []StatusPageComponent) []interface{} {
+	result := make([]interface{}, 0, len(components))
+	for _, c := range components {
+		result = append(result, map[string]interface{}{
+			"display_name": c.DisplayName,
+			"service_id":   c.ServiceID,
+			"project_id":   c.ProjectID,
+			"group":        c.Group,
+		})
+	}
+	return result
+}
+
+func expandStatusPageTheme(v interface{}) *StatusPageTheme {
+	m := firstBlock(v)
+	if m == nil {
+		return nil
+	}
+	var theme StatusPageTheme
+	theme.PrimaryColor, _ = m["primary_color"].(string)
+	theme.LogoURL, _ = m["logo_url"].(string)
+	theme.Mode, _ = m["mode"].(string)
+	return &theme
+}
+
+func flattenStatusPageTheme(theme *StatusPageTheme) []interface{} {
+	if theme == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"primary_color": theme.PrimaryColor,
+		"logo_url":      theme.LogoURL,
+		"mode":          theme.Mode,
+	}}
+}
+
+func expandStatusPage(d *schema.ResourceData) (StatusPage, error) {
+	organization, ok := d.Get("organization").(string)
+	if !ok {
+		return StatusPage{}, fmt.Errorf("organization must be a string")
+	}
+	slug, ok := d.Get("slug").(string)
+	if !ok {
+		return StatusPage{}, fmt.Errorf("slug must be a string")
+	}
+	title, ok := d.Get("title").(string)
+	if !ok {
+		return StatusPage{}, fmt.Errorf("title must be a string")
+	}
+	customDomain, ok := d.Get("custom_domain").(string)
+	if !ok {
+		return StatusPage{}, fmt.Errorf("custom_domain must be a string")
+	}
+	components, ok := d.Get("component").([]interface{})
+	if !ok {
+		return StatusPage{}, fmt.Errorf("component must be a list")
+	}
+	pageID, ok := d.Get("page_id").(string)
+	if !ok {
+		return StatusPage{}, fmt.Errorf("page_id must be a string")
+	}
+	return StatusPage{
+		ID:           pageID,
+		Organization: organization,
+		Slug:         slug,
+		Title:        title,
+		CustomDomain: customDomain,
+		Components:   expandStatusPageComponents(components),
+		Theme:        expandStatusPageTheme(d.Get("theme")),
+	}, nil
+}
+
+func resourceStatusPageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	page, err := expandStatusPage(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var envelope StatusPageEnvelope
+	if diags := config.writeJSON(ctx, http.MethodPut, statusPagesPath, "Unable to create status page", page, &envelope); diags.HasError() {
+		return diags
+	}
+	if envelope.StatusPage == nil || envelope.StatusPage.ID == "" {
+		return diag.Errorf("status page ID not found in response")
+	}
+
+	d.SetId(buildID(page.Organization, envelope.StatusPage.ID))
+	return resourceStatusPageRead(ctx, d, meta)
+}
+
+func resourceStatusPageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/page_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	org, pageID := parts[0], parts[1]
+
+	var result StatusPageListResponse
+	gone, diags := config.readJSON(ctx, organizationListPath(statusPagesPath, org), "Unable to read status pages", &result)
+	if diags.HasError() {
+		return diags
+	}
+	if gone {
+		log.Printf("[WARN] Organization %q not found, removing status page %q from state", org, pageID)
+		d.SetId("")
+		return nil
+	}
+	if result.StatusPages == nil {
+		return diag.Errorf("response does not contain StatusPages")
+	}
+
+	for _, page := range *result.StatusPages {
+		if page.ID != pageID {
+			continue
+		}
+		if err := d.Set("organization", org); err != nil {
+			return diag.Errorf("error setting organization: %s", err)
+		}
+		if err := d.Set("page_id", page.ID); err != nil {
+			return diag.Errorf("error setting page_id: %s", err)
+		}
+		if err := d.Set("slug", page.Slug); err != nil {
+			return diag.Errorf("error setting slug: %s", err)
+		}
+		if err := d.Set("title", page.Title); err != nil {
+			return diag.Errorf("error setting title: %s", err)
+		}
+		if err := d.Set("custom_domain", page.CustomDomain); err != nil {
+			return diag.Errorf("error setting custom_domain: %s", err)
+		}
+		// The backend returns components in display order, so reordering
+		// them in the UI shows up as a diff.
+		if err := d.Set("component", flattenStatusPageComponents(page.Components)); err != nil {
+			return diag.Errorf("error setting component: %s", err)
+		}
+		if err := d.Set("theme", flattenStatusPageTheme(page.Theme)); err != nil {
+			return diag.Errorf("error setting theme: %s", err)
+		}
+		if err := d.Set("url", page.URL); err != nil {
+			return diag.Errorf("error setting url: %s", err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] Status page %q not found in organization %q, removing from state", pageID, org)
+	d.SetId("")
+	return nil
+}
+
+func resourceStatusPageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	page, err := expandStatusPage(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := config.writeJSON(ctx, http.MethodPut, statusPagesPath, "Unable to update status page", page, nil); diags.HasError() {
+		return diags
+	}
+	return resourceStatusPageRead(ctx, d, meta)
+}
+
+func resourceStatusPageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/page_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	payload := map[string]string{
+		"id":           parts[1],
+		"organization": parts[0],
+	}
+	if diags := config.deleteJSON(ctx, statusPagesPath, "Unable to delete status page", payload); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
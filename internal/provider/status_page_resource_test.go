@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccStatusPageResource(t *testing.T) {
+	token := os.Getenv("EXAMPLE_TOKEN")
+	if token == "" {
+		t.Fatal("EXAMPLE_TOKEN is not set in environment variables")
+	}
+
+	config := func(first, second string) string {
+		return `
+provider "goliatdashboard" {
+  backend_url = "https://demo.goliat-dashboard.com"
+  token       = "` + token + `"
+}
+
+resource "goliatdashboard_organization" "test_org" {
+  name = "new_provider_org"
+  type = "providerOrganizations"
+}
+
+resource "goliatdashboard_project" "web" {
+  organization = goliatdashboard_organization.test_org.name
+  name         = "Website"
+}
+
+resource "goliatdashboard_project" "api" {
+  organization = goliatdashboard_organization.test_org.name
+  name         = "API"
+}
+
+resource "goliatdashboard_status_page" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  slug         = "provider-test"
+  title        = "Provider Test"
+
+  component {
+    display_name = "` + first + `"
+    project_id   = goliatdashboard_project.` + first + `.id
+  }
+
+  component {
+    display_name = "` + second + `"
+    project_id   = goliatdashboard_project.` + second + `.id
+  }
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"goliatdashboard": func() (*schema.Provider, error) { //nolint:unparam
+				return Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("web", "api"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_status_page.test", "component.0.display_name", "web"),
+					resource.TestCheckResourceAttr("goliatdashboard_status_page.test", "component.1.display_name", "api"),
+				),
+			},
+			{
+				Config: config("api", "web"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_status_page.test", "component.0.display_name", "api"),
+					resource.TestCheckResourceAttr("goliatdashboard_status_page.test", "component.1.display_name", "web"),
+				),
+			},
+			{
+				ResourceName:      "goliatdashboard_status_page.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceStatusPageCustomizeDiff_UnknownServiceID(t *testing.T) {
+	config := func(serviceID cty.Value) map[string]cty.Value {
+		return map[string]cty.Value{
+			"organization": cty.StringVal("new_provider_org"),
+			"slug":         cty.StringVal("acme"),
+			"title":        cty.StringVal("Acme status"),
+			"component": cty.TupleVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"display_name": cty.StringVal("API"),
+					"service_id":   serviceID,
+				}),
+				cty.ObjectVal(map[string]cty.Value{
+					"display_name": cty.StringVal("Dashboard"),
+					"project_id":   cty.StringVal("prj_1"),
+				}),
+			}),
+		}
+	}
+
+	// The service is created in the same apply, so its ID is unknown.
+	assert.NoError(t, planResource(t, resourceStatusPage(), config(cty.UnknownVal(cty.String))))
+
+	err := planResource(t, resourceStatusPage(), config(cty.NullVal(cty.String)))
+	assert.ErrorContains(t, err, `component.0 ("API"): exactly one of service_id and project_id must be set`)
+}
+
+func TestResourceStatusPageRead_Order(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadFixture(t, "status_page_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceStatusPage().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org/page_1")
+
+	diags := resourceStatusPageRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 3, d.Get("component.#"))
+	assert.Equal(t, "Website", d.Get("component.0.display_name"))
+	assert.Equal(t, "API", d.Get("component.1.display_name"))
+	assert.Equal(t, "Backend", d.Get("component.2.group"))
+	assert.Equal(t, "dark", d.Get("theme.0.mode"))
+	assert.Equal(t, "https://status.acme.com", d.Get("url"))
+}
+
+func TestResourceStatusPageUpdate_SendsOrder(t *testing.T) {
+	var sent StatusPage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			_ = json.NewDecoder(r.Body).Decode(&sent)
+			_, _ = w.Write([]byte(`{"statusPage":{"id":"page_1"}}`))
+			return
+		}
+		_, _ = w.Write(loadFixture(t, "status_page_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceStatusPage().Schema, map[string]interface{}{
+		"organization": "new_provider_org",
+		"slug":         "acme",
+		"title":        "Acme Status",
+		"component": []interface{}{
+			map[string]interface{}{"display_name": "Database", "service_id": "service_2"},
+			map[string]interface{}{"display_name": "Website", "project_id": "project_1"},
+		},
+	})
+	d.SetId("new_provider_org/page_1")
+	assert.NoError(t, d.Set("page_id", "page_1"))
+
+	diags := resourceStatusPageUpdate(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []StatusPageComponent{
+		{DisplayName: "Database", ServiceID: "service_2"},
+		{DisplayName: "Website", ProjectID: "project_1"},
+	}, sent.Components)
+	assert.Nil(t, sent.Theme)
+}
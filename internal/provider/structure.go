@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// domainNamePattern matches a lowercase, fully qualified domain name such as
+// "status.example.com".
+var domainNamePattern = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]*[a-z0-9])?\.)+[a-z]{2,}$`)
+
+// expandStringSet converts a schema.Set of strings into a sorted slice.
+func expandStringSet(set *schema.Set) []string {
+	result := make([]string, 0, set.Len())
+	for _, v := range set.List() {
+		if s, ok := v.(string); ok {
+			result = append(result, s)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// expandStringMap converts a TypeMap value into a map of strings.
+func expandStringMap(m map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(m))
+	for k, v := range m {
+		if s, ok := v.(string); ok {
+			result[k] = s
+		}
+	}
+	return result
+}
+
+// mergeLabels returns the provider default labels overlaid with the resource
+// labels. Resource labels win when both define the same key.
+func mergeLabels(defaults, labels map[string]string) map[string]string {
+	result := make(map[string]string, len(defaults)+len(labels))
+	for k, v := range defaults {
+		result[k] = v
+	}
+	for k, v := range labels {
+		result[k] = v
+	}
+	return result
+}
+
+// configuredLabels strips the keys injected by the provider default labels
+// from the labels returned by the backend, so they do not show up as a diff
+// against the resource configuration. A key the configuration sets itself, or
+// whose value differs from the default, is kept.
+func configuredLabels(all, defaults map[string]string, configured map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(all))
+	for k, v := range all {
+		if def, ok := defaults[k]; ok && def == v {
+			if _, set := configured[k]; !set {
+				continue
+			}
+		}
+		result[k] = v
+	}
+	return result
+}
+
+// firstBlock returns the attributes of the single block in a MaxItems: 1
+// list, or nil if the block is absent.
+func firstBlock(v interface{}) map[string]interface{} {
+	blocks, ok := v.([]interface{})
+	if !ok || len(blocks) == 0 {
+		return nil
+	}
+	m, ok := blocks[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return m
+}
+
+// getWriteOnlyString returns the configured value of the write-only string
+// attribute at path, or "" if it is not set. Write-only values never reach
+// the plan or state, so they are read from the raw configuration.
+func getWriteOnlyString(d *schema.ResourceData, path cty.Path) (string, error) {
+	v, diags := d.GetRawConfigAt(path)
+	if diags.HasError() {
+		return "", fmt.Errorf("error reading write-only value: %s", diags[0].Summary)
+	}
+	if !v.Type().Equals(cty.String) || v.IsNull() || !v.IsKnown() {
+		return "", nil
+	}
+	return v.AsString(), nil
+}
+
+// unknownConfigBlocks returns the indexes of the key blocks in the
+// configuration whose attrs, or whole contents when attrs is empty, are not
+// known yet. ResourceDiff reads unknown nested values as zero values, so
+// cross-field checks must skip these blocks until their values are known.
+func unknownConfigBlocks(d *schema.ResourceDiff, key string, attrs ...string) map[int]bool {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+	blocks := config.GetAttr(key)
+	if blocks.IsNull() || !blocks.IsKnown() {
+		return nil
+	}
+	unknown := make(map[int]bool)
+	for i, it := 0, blocks.ElementIterator(); it.Next(); i++ {
+		_, block := it.Element()
+		if len(attrs) == 0 && !block.IsWhollyKnown() {
+			unknown[i] = true
+		}
+		for _, attr := range attrs {
+			if !block.GetAttr(attr).IsWhollyKnown() {
+				unknown[i] = true
+			}
+		}
+	}
+	return unknown
+}
+
+// buildID joins the parts of a composite resource ID.
+func buildID(parts ...string) string {
+	return strings.Join(parts, "/")
+}
+
+// parseID splits a composite resource ID into exactly n non-empty parts.
+// format describes the expected layout for the error message.
+func parseID(id string, n int, format string) ([]string, error) {
+	parts := strings.SplitN(id, "/", n)
+	if len(parts) != n {
+		return nil, fmt.Errorf("unexpected ID %q, expected %s", id, format)
+	}
+	for _, p := range parts {
+		if p == "" {
+			return nil, fmt.Errorf("unexpected ID %q, expected %s", id, format)
+		}
+	}
+	return parts, nil
+}
+
+// suppressEquivalentRFC3339 suppresses the diff between two RFC3339
+// timestamps that denote the same instant, such as "2025-01-01T00:00:00Z"
+// and "2025-01-01T00:00:00.000Z".
+func suppressEquivalentRFC3339(_, old, new string, _ *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
+// validateDuration is a schema.SchemaValidateFunc that accepts positive Go
+// duration strings such as "5m" or "1h30m".
+func validateDuration(v interface{}, k string) ([]string, []error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return nil, []error{fmt.Errorf("%s: %q is not a valid duration such as \"5m\": %w", k, s, err)}
+	}
+	if d <= 0 {
+		return nil, []error{fmt.Errorf("%s: expected a positive duration, got %q", k, s)}
+	}
+	return nil, nil
+}
+
+// suppressEquivalentDuration suppresses the diff between two Go durations of
+// the same length, such as "5m" and "5m0s".
+func suppressEquivalentDuration(_, old, new string, _ *schema.ResourceData) bool {
+	oldDuration, err := time.ParseDuration(old)
+	if err != nil {
+		return false
+	}
+	newDuration, err := time.ParseDuration(new)
+	if err != nil {
+		return false
+	}
+	return oldDuration == newDuration
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// planResource plans the creation of r from config the way Terraform does,
+// so values left unknown in config stay unknown in CustomizeDiff.
+func planResource(t *testing.T, r *schema.Resource, config map[string]cty.Value) error {
+	t.Helper()
+	block := r.CoreConfigSchema()
+	raw, err := block.CoerceValue(cty.ObjectVal(config))
+	if err != nil {
+		t.Fatalf("error converting config: %s", err)
+	}
+	_, err = r.Diff(context.Background(), &terraform.InstanceState{RawConfig: raw}, terraform.NewResourceConfigShimmed(raw, block), nil)
+	return err
+}
+
+// testResourceDataConfig returns resource data whose state and raw
+// configuration both hold config, so functions that read write-only
+// attributes can be called directly.
+func testResourceDataConfig(t *testing.T, r *schema.Resource, config map[string]cty.Value) *schema.ResourceData {
+	t.Helper()
+	raw, err := r.CoreConfigSchema().CoerceValue(cty.ObjectVal(config))
+	if err != nil {
+		t.Fatalf("error converting config: %s", err)
+	}
+	state := terraform.NewInstanceStateShimmedFromValue(raw, r.SchemaVersion)
+	state.RawConfig = raw
+	return r.Data(state)
+}
+
+// applyResource creates typeName from config through the provider's gRPC
+// server, as Terraform does, and returns the new state with the diagnostics
+// of the apply. Unlike schema.TestResourceDataRaw, this passes the raw
+// configuration along, so write-only attributes can be read, and it returns
+// the state Terraform would store.
+func applyResource(t *testing.T, config *Config, typeName string, attrs map[string]cty.Value) (cty.Value, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+	p := Provider()
+	p.SetMeta(config)
+	server := schema.NewGRPCProviderServer(p)
+
+	block := p.ResourcesMap[typeName].CoreConfigSchema()
+	ty := block.ImpliedType()
+	raw, err := block.CoerceValue(cty.ObjectVal(attrs))
+	if err != nil {
+		t.Fatalf("error converting config: %s", err)
+	}
+	encode := func(v cty.Value) *tfprotov5.DynamicValue {
+		b, err := msgpack.Marshal(v, ty)
+		if err != nil {
+			t.Fatalf("error encoding value: %s", err)
+		}
+		return &tfprotov5.DynamicValue{MsgPack: b}
+	}
+	fail := func(step string, diags []*tfprotov5.Diagnostic) {
+		for _, d := range diags {
+			if d.Severity == tfprotov5.DiagnosticSeverityError {
+				t.Fatalf("%s: %s: %s", step, d.Summary, d.Detail)
+			}
+		}
+	}
+
+	validated, err := server.ValidateResourceTypeConfig(ctx, &tfprotov5.ValidateResourceTypeConfigRequest{
+		TypeName:           typeName,
+		Config:             encode(raw),
+		ClientCapabilities: &tfprotov5.ValidateResourceTypeConfigClientCapabilities{WriteOnlyAttributesAllowed: true},
+	})
+	if err != nil {
+		t.Fatalf("error validating: %s", err)
+	}
+	fail("validate", validated.Diagnostics)
+
+	prior := encode(cty.NullVal(ty))
+	planned, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+		TypeName:         typeName,
+		PriorState:       prior,
+		ProposedNewState: encode(raw),
+		Config:           encode(raw),
+	})
+	if err != nil {
+		t.Fatalf("error planning: %s", err)
+	}
+	fail("plan", planned.Diagnostics)
+
+	applied, err := server.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+		TypeName:     typeName,
+		PriorState:   prior,
+		PlannedState: planned.PlannedState,
+		Config:       encode(raw),
+	})
+	if err != nil {
+		t.Fatalf("error applying: %s", err)
+	}
+	var diags diag.Diagnostics
+	for _, d := range applied.Diagnostics {
+		severity := diag.Error
+		if d.Severity == tfprotov5.DiagnosticSeverityWarning {
+			severity = diag.Warning
+		}
+		diags = append(diags, diag.Diagnostic{Severity: severity, Summary: d.Summary, Detail: d.Detail})
+	}
+	if applied.NewState == nil {
+		return cty.NullVal(ty), diags
+	}
+	state, err := msgpack.Unmarshal(applied.NewState.MsgPack, ty)
+	if err != nil {
+		t.Fatalf("error decoding state: %s", err)
+	}
+	return state, diags
+}
+
+func TestExpandStringSet(t *testing.T) {
+	set := schema.NewSet(schema.HashString, []interface{}{"prod", "billing", "core"})
+	assert.Equal(t, []string{"billing", "core", "prod"}, expandStringSet(set))
+}
+
+func TestMergeLabels(t *testing.T) {
+	defaults := map[string]string{"team": "platform", "cost_center": "cc-100"}
+	labels := map[string]string{"cost_center": "cc-200", "env": "prod"}
+
+	assert.Equal(t, map[string]string{
+		"team":        "platform",
+		"cost_center": "cc-200",
+		"env":         "prod",
+	}, mergeLabels(defaults, labels))
+}
+
+func TestConfiguredLabels(t *testing.T) {
+	defaults := map[string]string{"team": "platform", "cost_center": "cc-100", "owner": "sre"}
+	all := map[string]string{
+		"team":        "platform",
+		"cost_center": "cc-100",
+		"owner":       "payments",
+		"env":         "prod",
+	}
+	configured := map[string]interface{}{"cost_center": "cc-100", "env": "prod"}
+
+	// team is injected by the provider and dropped; cost_center is kept
+	// because the configuration sets it; owner differs from its default.
+	assert.Equal(t, map[string]string{
+		"cost_center": "cc-100",
+		"owner":       "payments",
+		"env":         "prod",
+	}, configuredLabels(all, defaults, configured))
+}
+
+func TestSuppressEquivalentRFC3339(t *testing.T) {
+	assert.True(t, suppressEquivalentRFC3339("", "2999-01-01T00:00:00.000Z", "2999-01-01T00:00:00Z", nil))
+	assert.True(t, suppressEquivalentRFC3339("", "2999-01-01T01:00:00+01:00", "2999-01-01T00:00:00Z", nil))
+	assert.False(t, suppressEquivalentRFC3339("", "2999-01-01T00:00:00Z", "2999-01-02T00:00:00Z", nil))
+	assert.False(t, suppressEquivalentRFC3339("", "", "2999-01-01T00:00:00Z", nil))
+}
+
+func TestValidateDuration(t *testing.T) {
+	for _, v := range []string{"30s", "5m", "1h30m"} {
+		_, errs := validateDuration(v, "for_duration")
+		assert.Empty(t, errs, v)
+	}
+	for _, v := range []string{"", "5", "five minutes", "0s", "-1m"} {
+		_, errs := validateDuration(v, "for_duration")
+		assert.NotEmpty(t, errs, v)
+	}
+}
+
+func TestSuppressEquivalentDuration(t *testing.T) {
+	assert.True(t, suppressEquivalentDuration("", "5m0s", "5m", nil))
+	assert.True(t, suppressEquivalentDuration("", "300s", "5m", nil))
+	assert.False(t, suppressEquivalentDuration("", "5m", "10m", nil))
+	assert.False(t, suppressEquivalentDuration("", "", "5m", nil))
+}
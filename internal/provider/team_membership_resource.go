@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const teamMembershipsPath = "/api/public/provider/team-memberships"
+
+type TeamMembership struct {
+	Organization string `json:"organization"`
+	TeamID       string `json:"teamId"`
+	UserID       string `json:"userId"`
+	Role         string `json:"role"`
+}
+
+// TeamMembershipListResponse is the body returned when listing the team
+// memberships of an organization.
+type TeamMembershipListResponse struct {
+	TeamMemberships *[]TeamMembership `json:"TeamMemberships"`
+}
+
+func resourceTeamMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTeamMembershipPut,
+		ReadContext:   resourceTeamMembershipRead,
+		UpdateContext: resourceTeamMembershipPut,
+		DeleteContext: resourceTeamMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"team_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "member",
+				ValidateFunc: validation.StringInSlice([]string{"member", "maintainer"}, false),
+			},
+		},
+	}
+}
+
+func expandTeamMembership(d *schema.ResourceData) (TeamMembership, error) {
+	organization, ok := d.Get("organization").(string)
+	if !ok {
+		return TeamMembership{}, fmt.Errorf("organization must be a string")
+	}
+	teamID, ok := d.Get("team_id").(string)
+	if !ok {
+		return TeamMembership{}, fmt.Errorf("team_id must be a string")
+	}
+	userID, ok := d.Get("user_id").(string)
+	if !ok {
+		return TeamMembership{}, fmt.Errorf("user_id must be a string")
+	}
+	role, ok := d.Get("role").(string)
+	if !ok {
+		return TeamMembership{}, fmt.Errorf("role must be a string")
+	}
+	return TeamMembership{
+		Organization: organization,
+		TeamID:       teamID,
+		UserID:       userID,
+		Role:         role,
+	}, nil
+}
+
+// resourceTeamMembershipPut creates or updates the membership; the backend
+// upserts on organization, team and user.
+func resourceTeamMembershipPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	membership, err := expandTeamMembership(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := config.writeJSON(ctx, http.MethodPut, teamMembershipsPath, "Unable to save team membership", membership, nil); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(buildID(membership.Organization, membership.TeamID, membership.UserID))
+	return resourceTeamMembershipRead(ctx, d, meta)
+}
+
+func resourceTeamMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 3, "organization/team_id/user_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	org, teamID, userID := parts[0], parts[1], parts[2]
+
+	var result TeamMembershipListResponse
+	gone, diags := config.readJSON(ctx, organizationListPath(teamMembershipsPath, org), "Unable to read team memberships", &result)
+	if diags.HasError() {
+		return diags
+	}
+	if gone {
+		log.Printf("[WARN] Organization %q not found, removing team membership %q from state", org, d.Id())
+		d.SetId("")
+		return nil
+	}
+	if result.TeamMemberships == nil {
+		return diag.Errorf("response does not contain TeamMemberships")
+	}
+
+	for _, m := range *result.TeamMemberships {
+		if m.TeamID != teamID || m.UserID != userID {
+			continue
+		}
+		if err := d.Set("organization", org); err != nil {
+			return diag.Errorf("error setting organization: %s", err)
+		}
+		if err := d.Set("team_id", m.TeamID); err != nil {
+			return diag.Errorf("error setting team_id: %s", err)
+		}
+		if err := d.Set("user_id", m.UserID); err != nil {
+			return diag.Errorf("error setting user_id: %s", err)
+		}
+		if err := d.Set("role", m.Role); err != nil {
+			return diag.Errorf("error setting role: %s", err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] Team membership %q not found, removing from state", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceTeamMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	membership, err := expandTeamMembership(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := config.deleteJSON(ctx, teamMembershipsPath, "Unable to delete team membership", membership); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceTeamMembershipRead_Import(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadFixture(t, "team_membership_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceTeamMembership().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org/team_1/usr_2")
+
+	diags := resourceTeamMembershipRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "new_provider_org", d.Get("organization"))
+	assert.Equal(t, "team_1", d.Get("team_id"))
+	assert.Equal(t, "usr_2", d.Get("user_id"))
+	assert.Equal(t, "maintainer", d.Get("role"))
+}
+
+func TestResourceTeamMembershipRead_InvalidID(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceTeamMembership().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org/team_1")
+
+	diags := resourceTeamMembershipRead(context.Background(), d, &Config{})
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "organization/team_id/user_id")
+}
@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const teamsPath = "/api/public/provider/teams"
+
+type Team struct {
+	ID           string `json:"id"`
+	Organization string `json:"organization"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	RoleID       string `json:"roleId,omitempty"`
+}
+
+// TeamListResponse is the body returned when listing the teams of an
+// organization.
+type TeamListResponse struct {
+	Teams *[]Team `json:"Teams"`
+}
+
+// TeamEnvelope is the body returned when creating or updating a team.
+type TeamEnvelope struct {
+	Team *Team `json:"team"`
+}
+
+func resourceTeam() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTeamCreate,
+		ReadContext:   resourceTeamRead,
+		UpdateContext: resourceTeamUpdate,
+		DeleteContext: resourceTeamDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"role_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"team_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandTeam(d *schema.ResourceData) (Team, error) {
+	organization, ok := d.Get("organization").(string)
+	if !ok {
+		return Team{}, fmt.Errorf("organization must be a string")
+	}
+	name, ok := d.Get("name").(string)
+	if !ok {
+		return Team{}, fmt.Errorf("name must be a string")
+	}
+	description, ok := d.Get("description").(string)
+	if !ok {
+		return Team{}, fmt.Errorf("description must be a string")
+	}
+	roleID, ok := d.Get("role_id").(string)
+	if !ok {
+		return Team{}, fmt.Errorf("role_id must be a string")
+	}
+	teamID, ok := d.Get("team_id").(string)
+	if !ok {
+		return Team{}, fmt.Errorf("team_id must be a string")
+	}
+	return Team{
+		ID:           teamID,
+		Organization: organization,
+		Name:         name,
+		Description:  description,
+		RoleID:       roleID,
+	}, nil
+}
+
+func resourceTeamCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	team, err := expandTeam(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var envelope TeamEnvelope
+	if diags := config.writeJSON(ctx, http.MethodPut, teamsPath, "Unable to create team", team, &envelope); diags.HasError() {
+		return diags
+	}
+	if envelope.Team == nil || envelope.Team.ID == "" {
+		return diag.Errorf("team ID not found in response")
+	}
+
+	d.SetId(buildID(team.Organization, envelope.Team.ID))
+	return resourceTeamRead(ctx, d, meta)
+}
+
+func resourceTeamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/team_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	org, teamID := parts[0], parts[1]
+
+	var result TeamListResponse
+	gone, diags := config.readJSON(ctx, organizationListPath(teamsPath, org), "Unable to read teams", &result)
+	if diags.HasError() {
+		return diags
+	}
+	if gone {
+		log.Printf("[WARN] Organization %q not found, removing team %q from state", org, teamID)
+		d.SetId("")
+		return nil
+	}
+	if result.Teams == nil {
+		return diag.Errorf("response does not contain Teams")
+	}
+
+	for _, team := range *result.Teams {
+		if team.ID != teamID {
+			continue
+		}
+		if err := d.Set("organization", org); err != nil {
+			return diag.Errorf("error setting organization: %s", err)
+		}
+		if err := d.Set("team_id", team.ID); err != nil {
+			return diag.Errorf("error setting team_id: %s", err)
+		}
+		if err := d.Set("name", team.Name); err != nil {
+			return diag.Errorf("error setting name: %s", err)
+		}
+		if err := d.Set("description", team.Description); err != nil {
+			return diag.Errorf("error setting description: %s", err)
+		}
+		if err := d.Set("role_id", team.RoleID); err != nil {
+			return diag.Errorf("error setting role_id: %s", err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] Team %q not found in organization %q, removing from state", teamID, org)
+	d.SetId("")
+	return nil
+}
+
+func resourceTeamUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	team, err := expandTeam(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := config.writeJSON(ctx, http.MethodPut, teamsPath, "Unable to update team", team, nil); diags.HasError() {
+		return diags
+	}
+	return resourceTeamRead(ctx, d, meta)
+}
+
+func resourceTeamDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	team, err := expandTeam(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	payload := map[string]string{
+		"id":           team.ID,
+		"organization": team.Organization,
+	}
+	if diags := config.deleteJSON(ctx, teamsPath, "Unable to delete team", payload); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
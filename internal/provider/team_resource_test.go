@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccTeamResources(t *testing.T) {
+	token := os.Getenv("EXAMPLE_TOKEN")
+	if token == "" {
+		t.Fatal("EXAMPLE_TOKEN is not set in environment variables")
+	}
+
+	config := func(permission string) string {
+		return `
+provider "goliatdashboard" {
+  backend_url = "https://demo.goliat-dashboard.com"
+  token       = "` + token + `"
+}
+
+resource "goliatdashboard_organization" "test_org" {
+  name = "new_provider_org"
+  type = "providerOrganizations"
+}
+
+resource "goliatdashboard_project" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  name         = "Test Project"
+}
+
+resource "goliatdashboard_team" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  name         = "SRE"
+}
+
+resource "goliatdashboard_organization_member" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  email        = "member@goliat-dashboard.com"
+  role         = "member"
+}
+
+resource "goliatdashboard_team_membership" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  team_id      = goliatdashboard_team.test.team_id
+  user_id      = goliatdashboard_organization_member.test.user_id
+}
+
+resource "goliatdashboard_project_access" "test" {
+  organization = goliatdashboard_organization.test_org.name
+  project_id   = goliatdashboard_project.test.id
+  team_id      = goliatdashboard_team.test.team_id
+  permission   = "` + permission + `"
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"goliatdashboard": func() (*schema.Provider, error) { //nolint:unparam
+				return Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("read"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_team.test", "name", "SRE"),
+					resource.TestCheckResourceAttr("goliatdashboard_team_membership.test", "role", "member"),
+					resource.TestCheckResourceAttr("goliatdashboard_project_access.test", "permission", "read"),
+				),
+			},
+			{
+				Config: config("write"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_project_access.test", "permission", "write"),
+				),
+			},
+			{
+				ResourceName:      "goliatdashboard_team.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "goliatdashboard_team_membership.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "goliatdashboard_project_access.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceTeamRead_Drift(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadFixture(t, "team_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceTeam().Schema, map[string]interface{}{
+		"organization": "new_provider_org",
+		"name":         "SRE",
+		"description":  "Site reliability",
+	})
+	d.SetId("new_provider_org/team_1")
+
+	diags := resourceTeamRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "team_1", d.Get("team_id"))
+	assert.Equal(t, "Renamed in the UI", d.Get("description"))
+}
+
+func TestResourceTeamRead_Removed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Teams":[]}`))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceTeam().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org/team_1")
+
+	diags := resourceTeamRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Empty(t, d.Id())
+}
@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const webhooksPath = "/api/public/provider/webhooks"
+
+// webhookEvents are the events a webhook can subscribe to.
+var webhookEvents = []string{
+	"alert.firing",
+	"alert.resolved",
+	"incident.created",
+	"incident.updated",
+	"incident.resolved",
+	"maintenance.started",
+	"maintenance.ended",
+	"project.created",
+	"project.deleted",
+	"service.down",
+	"service.up",
+	"slo.budget_exhausted",
+}
+
+// Webhook delivers dashboard events to an external URL. Deliveries are signed
+// with an HMAC of the body using Secret, which the backend never returns; it
+// only reports the fingerprint of the key in use.
+type Webhook struct {
+	ID                    string   `json:"id"`
+	Organization          string   `json:"organization"`
+	ProjectID             string   `json:"projectId,omitempty"`
+	URL                   string   `json:"url"`
+	Events                []string `json:"events"`
+	Secret                string   `json:"secret,omitempty"`
+	Active                bool     `json:"active"`
+	ContentType           string   `json:"contentType"`
+	SigningKeyFingerprint string   `json:"signingKeyFingerprint,omitempty"`
+}
+
+// WebhookListResponse is the body returned when listing the webhooks of an
+// organization.
+type WebhookListResponse struct {
+	Webhooks *[]Webhook `json:"Webhooks"`
+}
+
+// WebhookEnvelope is the body returned when creating or updating a webhook.
+type WebhookEnvelope struct {
+	Webhook *Webhook `json:"webhook"`
+}
+
+func resourceWebhook() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWebhookCreate,
+		ReadContext:   resourceWebhookRead,
+		UpdateContext: resourceWebhookUpdate,
+		DeleteContext: resourceWebhookDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+			"events": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(webhookEvents, false),
+				},
+			},
+			"secret_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				WriteOnly:    true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(16, 256),
+			},
+			"secret_wo_version": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"content_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "application/json",
+				ValidateFunc: validation.StringInSlice([]string{"application/json", "application/x-www-form-urlencoded"}, false),
+			},
+			"webhook_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"signing_key_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: resourceWebhookCustomizeDiff,
+	}
+}
+
+// resourceWebhookCustomizeDiff marks signing_key_fingerprint as unknown when
+// a new secret is sent, so the plan shows the fingerprint the backend will
+// report after the apply.
+func resourceWebhookCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.HasChange("secret_wo_version") {
+		return nil
+	}
+	return d.SetNewComputed("signing_key_fingerprint")
+}
+
+func expandWebhook(d *schema.ResourceData) (Webhook, error) {
+	organization, ok := d.Get("organization").(string)
+	if !ok {
+		return Webhook{}, fmt.Errorf("organization must be a string")
+	}
+	projectID, ok := d.Get("project_id").(string)
+	if !ok {
+		return Webhook{}, fmt.Errorf("project_id must be a string")
+	}
+	url, ok := d.Get("url").(string)
+	if !ok {
+		return Webhook{}, fmt.Errorf("url must be a string")
+	}
+	events, ok := d.Get("events").(*schema.Set)
+	if !ok {
+		return Webhook{}, fmt.Errorf("events must be a set")
+	}
+	secret, err := getWriteOnlyString(d, cty.GetAttrPath("secret_wo"))
+	if err != nil {
+		return Webhook{}, err
+	}
+	active, ok := d.Get("active").(bool)
+	if !ok {
+		return Webhook{}, fmt.Errorf("active must be a bool")
+	}
+	contentType, ok := d.Get("content_type").(string)
+	if !ok {
+		return Webhook{}, fmt.Errorf("content_type must be a string")
+	}
+	webhookID, ok := d.Get("webhook_id").(string)
+	if !ok {
+		return Webhook{}, fmt.Errorf("webhook_id must be a string")
+	}
+	return Webhook{
+		ID:           webhookID,
+		Organization: organization,
+		ProjectID:    projectID,
+		URL:          url,
+		Events:       expandStringSet(events),
+		Secret:       secret,
+		Active:       active,
+		ContentType:  contentType,
+	}, nil
+}
+
+func resourceWebhookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	webhook, err := expandWebhook(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var envelope WebhookEnvelope
+	if diags := config.writeJSON(ctx, http.MethodPut, webhooksPath, "Unable to create webhook", webhook, &envelope); diags.HasError() {
+		return diags
+	}
+	if envelope.Webhook == nil || envelope.Webhook.ID == "" {
+		return diag.Errorf("webhook ID not found in response")
+	}
+
+	d.SetId(buildID(webhook.Organization, envelope.Webhook.ID))
+	return resourceWebhookRead(ctx, d, meta)
+}
+
+func resourceWebhookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/webhook_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	org, webhookID := parts[0], parts[1]
+
+	var result WebhookListResponse
+	gone, diags := config.readJSON(ctx, organizationListPath(webhooksPath, org), "Unable to read webhooks", &result)
+	if diags.HasError() {
+		return diags
+	}
+	if gone {
+		log.Printf("[WARN] Organization %q not found, removing webhook %q from state", org, webhookID)
+		d.SetId("")
+		return nil
+	}
+	if result.Webhooks == nil {
+		return diag.Errorf("response does not contain Webhooks")
+	}
+
+	for _, webhook := range *result.Webhooks {
+		if webhook.ID != webhookID {
+			continue
+		}
+		if err := d.Set("organization", org); err != nil {
+			return diag.Errorf("error setting organization: %s", err)
+		}
+		if err := d.Set("webhook_id", webhook.ID); err != nil {
+			return diag.Errorf("error setting webhook_id: %s", err)
+		}
+		if err := d.Set("project_id", webhook.ProjectID); err != nil {
+			return diag.Errorf("error setting project_id: %s", err)
+		}
+		if err := d.Set("url", webhook.URL); err != nil {
+			return diag.Errorf("error setting url: %s", err)
+		}
+		if err := d.Set("events", webhook.Events); err != nil {
+			return diag.Errorf("error setting events: %s", err)
+		}
+		if err := d.Set("active", webhook.Active); err != nil {
+			return diag.Errorf("error setting active: %s", err)
+		}
+		if err := d.Set("content_type", webhook.ContentType); err != nil {
+			return diag.Errorf("error setting content_type: %s", err)
+		}
+		if err := d.Set("signing_key_fingerprint", webhook.SigningKeyFingerprint); err != nil {
+			return diag.Errorf("error setting signing_key_fingerprint: %s", err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] Webhook %q not found in organization %q, removing from state", webhookID, org)
+	d.SetId("")
+	return nil
+}
+
+func resourceWebhookUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	webhook, err := expandWebhook(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := config.writeJSON(ctx, http.MethodPut, webhooksPath, "Unable to update webhook", webhook, nil); diags.HasError() {
+		return diags
+	}
+	return resourceWebhookRead(ctx, d, meta)
+}
+
+func resourceWebhookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, ok := meta.(*Config)
+	if !ok {
+		return diag.Errorf("error converting meta to *Config")
+	}
+
+	parts, err := parseID(d.Id(), 2, "organization/webhook_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	payload := map[string]string{
+		"id":           parts[1],
+		"organization": parts[0],
+	}
+	if diags := config.deleteJSON(ctx, webhooksPath, "Unable to delete webhook", payload); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// webhookSigningKeyFingerprint returns the fingerprint the backend reports
+// for secret: the first 16 hex digits of its SHA-256 digest.
+func webhookSigningKeyFingerprint(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])[:16]
+}
+
+func TestAccWebhookResource(t *testing.T) {
+	token := os.Getenv("EXAMPLE_TOKEN")
+	if token == "" {
+		t.Fatal("EXAMPLE_TOKEN is not set in environment variables")
+	}
+
+	config := func(secret string, version int) string {
+		return `
+provider "goliatdashboard" {
+  backend_url = "https://demo.goliat-dashboard.com"
+  token       = "` + token + `"
+}
+
+resource "goliatdashboard_organization" "test_org" {
+  name = "new_provider_org"
+  type = "providerOrganizations"
+}
+
+resource "goliatdashboard_webhook" "test" {
+  organization      = goliatdashboard_organization.test_org.name
+  url               = "https://demo.goliat-dashboard.com/hooks/test"
+  events            = ["incident.created", "incident.resolved"]
+  secret_wo         = "` + secret + `"
+  secret_wo_version = ` + strconv.Itoa(version) + `
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"goliatdashboard": func() (*schema.Provider, error) { //nolint:unparam
+				return Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("first-signing-key-0001", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_webhook.test", "active", "true"),
+					resource.TestCheckResourceAttr("goliatdashboard_webhook.test", "content_type", "application/json"),
+					resource.TestCheckResourceAttr("goliatdashboard_webhook.test", "signing_key_fingerprint", webhookSigningKeyFingerprint("first-signing-key-0001")),
+				),
+			},
+			{
+				Config: config("second-signing-key-0002", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("goliatdashboard_webhook.test", "signing_key_fingerprint", webhookSigningKeyFingerprint("second-signing-key-0002")),
+				),
+			},
+			{
+				ResourceName:            "goliatdashboard_webhook.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_wo_version"},
+			},
+		},
+	})
+}
+
+func TestResourceWebhookCustomizeDiff_SecretVersion(t *testing.T) {
+	r := resourceWebhook()
+	block := r.CoreConfigSchema()
+	config := func(version int64) cty.Value {
+		raw, err := block.CoerceValue(cty.ObjectVal(map[string]cty.Value{
+			"organization":      cty.StringVal("new_provider_org"),
+			"url":               cty.StringVal("https://hooks.example.com/goliat"),
+			"events":            cty.SetVal([]cty.Value{cty.StringVal("incident.created")}),
+			"secret_wo_version": cty.NumberIntVal(version),
+		}))
+		if err != nil {
+			t.Fatalf("error converting config: %s", err)
+		}
+		return raw
+	}
+	state := terraform.NewInstanceStateShimmedFromValue(config(1), r.SchemaVersion)
+	state.ID = "new_provider_org/webhook_1"
+	state.Attributes["id"] = state.ID
+	state.Attributes["active"] = "true"
+	state.Attributes["content_type"] = "application/json"
+	state.Attributes["signing_key_fingerprint"] = "3fc8b345d6dfebde"
+
+	diff := func(version int64) *terraform.InstanceDiff {
+		raw := config(version)
+		state.RawConfig = raw
+		d, err := r.Diff(context.Background(), state, terraform.NewResourceConfigShimmed(raw, block), nil)
+		assert.NoError(t, err)
+		return d
+	}
+
+	// The same version sends no new secret, so the fingerprint is kept.
+	assert.Nil(t, diff(1))
+
+	// A new version sends the secret again; the backend reports the new
+	// fingerprint after the apply.
+	if d := diff(2); assert.NotNil(t, d) {
+		assert.True(t, d.Attributes["signing_key_fingerprint"].NewComputed)
+	}
+}
+
+func TestResourceWebhookCreate_SecretNotInState(t *testing.T) {
+	var created Webhook
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			_ = json.NewDecoder(r.Body).Decode(&created)
+			_, _ = w.Write([]byte(`{"webhook":{"id":"webhook_1"}}`))
+			return
+		}
+		_, _ = w.Write(loadFixture(t, "webhook_list.json"))
+	}))
+	defer server.Close()
+
+	state, diags := applyResource(t, &Config{BackendURL: server.URL, Token: "test"}, "goliatdashboard_webhook", map[string]cty.Value{
+		"organization":      cty.StringVal("new_provider_org"),
+		"url":               cty.StringVal("https://hooks.example.com/goliat"),
+		"events":            cty.SetVal([]cty.Value{cty.StringVal("incident.created"), cty.StringVal("incident.resolved")}),
+		"secret_wo":         cty.StringVal("s3cr3t-signing-key"),
+		"secret_wo_version": cty.NumberIntVal(1),
+	})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "s3cr3t-signing-key", created.Secret)
+
+	assert.True(t, state.GetAttr("secret_wo").IsNull())
+	assert.Equal(t, cty.NumberIntVal(1), state.GetAttr("secret_wo_version"))
+	assert.Equal(t, webhookSigningKeyFingerprint("s3cr3t-signing-key"), state.GetAttr("signing_key_fingerprint").AsString())
+}
+
+func TestResourceWebhookRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(loadFixture(t, "webhook_list.json"))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceWebhook().Schema, map[string]interface{}{})
+	d.SetId("new_provider_org/webhook_1")
+
+	diags := resourceWebhookRead(context.Background(), d, &Config{BackendURL: server.URL, Token: "test"})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "https://hooks.example.com/goliat", d.Get("url"))
+	assert.ElementsMatch(t, []interface{}{"incident.created", "incident.resolved"}, d.Get("events").(*schema.Set).List()) //nolint:forcetypeassert
+	assert.Equal(t, "3fc8b345d6dfebde", d.Get("signing_key_fingerprint"))
+}